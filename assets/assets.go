@@ -0,0 +1,9 @@
+// Package assets embeds the game's data files so the binary runs from any directory
+package assets
+
+import "embed"
+
+// Files holds every shipped asset, with paths relative to this directory (e.g. "level/1.json")
+//
+//go:embed car_data.json characters config level road
+var Files embed.FS
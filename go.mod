@@ -7,6 +7,7 @@ toolchain go1.24.10
 require (
 	github.com/hajimehoshi/bitmapfont/v4 v4.1.0
 	github.com/hajimehoshi/ebiten/v2 v2.9.4
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -1,15 +1,45 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"os"
 
+	"github.com/golangdaddy/roadster/pkg/assets"
 	"github.com/golangdaddy/roadster/pkg/game"
+	"github.com/golangdaddy/roadster/pkg/mods"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func main() {
+	// Assets are embedded in the binary; -assets (or ROADSTER_ASSETS) layers a directory on top,
+	// e.g. -assets assets for level editing with hot-reload
+	assetDir := flag.String("assets", os.Getenv("ROADSTER_ASSETS"), "directory of asset files that override the embedded ones (default $ROADSTER_ASSETS)")
+	modDir := flag.String("mods", "mods", "directory of mod packs (cars, levels, road textures)")
+	snapshotFile := flag.String("snapshot", "", "start straight into a saved gameplay snapshot (e.g. a crash dump)")
+	flag.Parse()
+
+	if *assetDir != "" {
+		if err := assets.Default.SetOverrideDir(*assetDir); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Using asset overrides from %s", *assetDir)
+	} else {
+		log.Printf("Level hot-reload is off: pass -assets <dir> (or set ROADSTER_ASSETS) to layer editable assets over the embedded ones")
+	}
+
+	// Mount mod packs over the base assets before anything is loaded
+	if _, err := mods.Load(assets.Default, *modDir); err != nil {
+		log.Printf("Failed to load mods: %v", err)
+	}
+
 	// Create the game instance
 	g := game.NewGame()
+	if *snapshotFile != "" {
+		if err := g.ReplaySnapshot(*snapshotFile); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	// Set up Ebiten game settings
 	ebiten.SetWindowSize(1024, 600)
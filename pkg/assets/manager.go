@@ -0,0 +1,328 @@
+// Package assets loads game data through a single fs.FS so the game does not depend on the working directory
+package assets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/png" // Register PNG decoder for road textures and character sprites
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	embedded "github.com/golangdaddy/roadster/assets"
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Asset paths, relative to the asset root
+const (
+	CarDataPath   = "car_data.json"
+	GameRulesPath = "config/game_rules.yaml"
+	LevelGlob     = "level/*.json"
+	RoadTileGlob  = "road/*.png"
+)
+
+// Manager reads assets from an fs.FS and caches decoded images
+type Manager struct {
+	mu          sync.Mutex
+	base        fs.FS // Embedded assets, with the override directory layered on top
+	packs       []mountedPack
+	fsys        fs.FS // base with every mounted pack layered on top
+	overrideDir string
+	images      map[string]*ebiten.Image
+}
+
+// mountedPack is an extra layer of assets (a mod) mounted over the base assets
+type mountedPack struct {
+	name string
+	fsys fs.FS
+}
+
+// Default is the manager every package loads assets through.
+// It serves the embedded files until SetOverrideDir is called.
+var Default = NewManager(embedded.Files)
+
+// NewManager creates a manager backed by the given filesystem
+func NewManager(fsys fs.FS) *Manager {
+	return &Manager{
+		base:   fsys,
+		fsys:   fsys,
+		images: make(map[string]*ebiten.Image),
+	}
+}
+
+// Mount layers a pack of assets over the base assets. Packs mounted later win over earlier ones.
+// Levels and road textures are overridden file by file; car data is merged by car ID (see CarData).
+func (m *Manager) Mount(name string, fsys fs.FS) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.packs = append(m.packs, mountedPack{name: name, fsys: fsys})
+	m.rebuild()
+}
+
+// rebuild recombines the base and pack layers. Callers must hold m.mu.
+func (m *Manager) rebuild() {
+	m.images = make(map[string]*ebiten.Image)
+
+	if len(m.packs) == 0 {
+		m.fsys = m.base
+		return
+	}
+
+	layers := make([]fs.FS, 0, len(m.packs)+1)
+	for i := len(m.packs) - 1; i >= 0; i-- {
+		layers = append(layers, m.packs[i].fsys)
+	}
+	layers = append(layers, m.base)
+	m.fsys = overlayFS{layers: layers}
+}
+
+// SetOverrideDir layers a directory on disk over the embedded assets.
+// Files found in dir win; anything missing falls back to the embedded copy.
+func (m *Manager) SetOverrideDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("asset override %s is not a directory", dir)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.overrideDir = dir
+	m.base = overlayFS{layers: []fs.FS{os.DirFS(dir), embedded.Files}}
+	m.rebuild()
+	return nil
+}
+
+// OverrideDir returns the directory layered over the embedded assets ("" if none)
+func (m *Manager) OverrideDir() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.overrideDir
+}
+
+// FS returns the filesystem assets are read from
+func (m *Manager) FS() fs.FS {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fsys
+}
+
+// ReadFile reads a raw asset file
+func (m *Manager) ReadFile(name string) ([]byte, error) {
+	return fs.ReadFile(m.FS(), name)
+}
+
+// Glob returns the asset paths matching pattern
+func (m *Manager) Glob(pattern string) ([]string, error) {
+	return fs.Glob(m.FS(), pattern)
+}
+
+// Image decodes an image asset, returning the cached copy on later calls
+func (m *Manager) Image(name string) (*ebiten.Image, error) {
+	m.mu.Lock()
+	if img, ok := m.images[name]; ok {
+		m.mu.Unlock()
+		return img, nil
+	}
+	fsys := m.fsys
+	m.mu.Unlock()
+
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	decoded, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	img := ebiten.NewImageFromImage(decoded)
+
+	m.mu.Lock()
+	m.images[name] = img
+	m.mu.Unlock()
+
+	return img, nil
+}
+
+// LevelFiles returns the paths of all level definitions
+func (m *Manager) LevelFiles() ([]string, error) {
+	return m.Glob(LevelGlob)
+}
+
+// Level decodes a level definition
+func (m *Manager) Level(name string) (*road.LevelDefinition, error) {
+	data, err := m.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var levelDef road.LevelDefinition
+	if err := json.Unmarshal(data, &levelDef); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return &levelDef, nil
+}
+
+// CarData decodes the car catalogue and merges in the car data of every mounted pack.
+// A pack entry replaces the base entry with the same ID; entries with new IDs are added.
+func (m *Manager) CarData() ([]models.CarData, error) {
+	m.mu.Lock()
+	base := m.base
+	packs := append([]mountedPack(nil), m.packs...)
+	m.mu.Unlock()
+
+	data, err := fs.ReadFile(base, CarDataPath)
+	if err != nil {
+		return nil, err
+	}
+	carDataList, err := ParseCarData(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", CarDataPath, err)
+	}
+
+	indexByID := make(map[int]int, len(carDataList))
+	for i, entry := range carDataList {
+		indexByID[entry.ID] = i
+	}
+
+	for _, pack := range packs {
+		data, err := fs.ReadFile(pack.fsys, CarDataPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pack.name, err)
+		}
+		packCars, err := ParseCarData(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", pack.name, CarDataPath, err)
+		}
+
+		for _, entry := range packCars {
+			if i, exists := indexByID[entry.ID]; exists {
+				carDataList[i] = entry
+				continue
+			}
+			indexByID[entry.ID] = len(carDataList)
+			carDataList = append(carDataList, entry)
+		}
+	}
+
+	return carDataList, nil
+}
+
+// GameRules decodes the game rules (XP curve and HUD switches)
+func (m *Manager) GameRules() (*progression.GameRules, error) {
+	data, err := m.ReadFile(GameRulesPath)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := progression.ParseRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", GameRulesPath, err)
+	}
+	return rules, nil
+}
+
+// ParseCarData decodes a car_data.json file.
+// The file is annotated with /* */ section comments, which are stripped before decoding.
+func ParseCarData(data []byte) ([]models.CarData, error) {
+	var carDataList []models.CarData
+	if err := json.Unmarshal(stripComments(data), &carDataList); err != nil {
+		return nil, err
+	}
+	return carDataList, nil
+}
+
+// RoadTextures loads every road tile, keyed by its road-type letter (road/A.png -> "A")
+func (m *Manager) RoadTextures() (map[string]*ebiten.Image, error) {
+	files, err := m.Glob(RoadTileGlob)
+	if err != nil {
+		return nil, err
+	}
+
+	textures := make(map[string]*ebiten.Image, len(files))
+	for _, file := range files {
+		img, err := m.Image(file)
+		if err != nil {
+			return textures, err
+		}
+		letter := strings.TrimSuffix(path.Base(file), path.Ext(file))
+		textures[letter] = img
+	}
+	return textures, nil
+}
+
+// CharacterSpritePath returns the asset path of a character's full body sprite (e.g. "man1")
+func CharacterSpritePath(characterID string) string {
+	return path.Join("characters", characterID+".png")
+}
+
+// HeadshotPath returns the asset path of a character's headshot
+func HeadshotPath(characterID string) string {
+	return path.Join("characters", "headshots", characterID+"_headshot.png")
+}
+
+// CharacterSprite loads a character's full body sprite
+func (m *Manager) CharacterSprite(characterID string) (*ebiten.Image, error) {
+	return m.Image(CharacterSpritePath(characterID))
+}
+
+// Headshot loads a character's headshot
+func (m *Manager) Headshot(characterID string) (*ebiten.Image, error) {
+	return m.Image(HeadshotPath(characterID))
+}
+
+// stripComments removes /* */ block comments that sit outside of JSON strings
+func stripComments(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	inString := false
+	escaped := false
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if inString {
+			out = append(out, c)
+			if escaped {
+				escaped = false
+			} else if c == '\\' {
+				escaped = true
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		if c == '"' {
+			inString = true
+			out = append(out, c)
+			continue
+		}
+
+		if c == '/' && i+1 < len(data) && data[i+1] == '*' {
+			end := strings.Index(string(data[i+2:]), "*/")
+			if end < 0 {
+				break
+			}
+			i += end + 3
+			continue
+		}
+
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package assets
+
+import (
+	"errors"
+	"io/fs"
+	"sort"
+)
+
+// overlayFS stacks several filesystems on top of each other.
+// Files are looked up in layer order, so earlier layers override later ones.
+type overlayFS struct {
+	layers []fs.FS
+}
+
+// Open returns the file from the first layer that has it
+func (o overlayFS) Open(name string) (fs.File, error) {
+	for _, layer := range o.layers {
+		f, err := layer.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir merges the directory listings of every layer (so fs.Glob sees all files)
+func (o overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := make(map[string]bool)
+	entries := make([]fs.DirEntry, 0)
+	found := false
+
+	for _, layer := range o.layers {
+		list, err := fs.ReadDir(layer, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		found = true
+		for _, entry := range list {
+			if seen[entry.Name()] {
+				continue
+			}
+			seen[entry.Name()] = true
+			entries = append(entries, entry)
+		}
+	}
+
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
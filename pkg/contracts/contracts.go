@@ -0,0 +1,104 @@
+// Package contracts offers delivery jobs on shop boards and describes the ones the player has taken on
+package contracts
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/progression"
+)
+
+// Kinds of contract
+const (
+	KindParcel  = "parcel"  // Deliver before the deadline
+	KindFragile = "fragile" // Deliver before the deadline without crashing
+)
+
+// Board and contract tuning
+const (
+	MaxActive      = 3 // Contracts the player can carry at once
+	OffersPerBoard = 3
+	ticksPerSecond = 60
+
+	parcelMPH         = 40.0  // Average speed the deadline allows for a parcel
+	fragileMPH        = 30.0  // Fragile cargo is given longer
+	slackSeconds      = 30.0  // Added to every deadline for stops and traffic
+	parcelReward      = 100.0 // Base pay, before the pay per mile
+	fragileReward     = 200.0
+	parcelPerMile     = 200.0
+	fragilePerMile    = 300.0
+	fragileXPMultiple = 2 // Fragile cargo is worth this many deliveries' XP
+)
+
+// Contract is a delivery the player has been offered or taken on
+type Contract struct {
+	ID          string  `json:"id"`
+	Kind        string  `json:"kind"`        // One of the Kind* constants
+	Destination string  `json:"destination"` // Exit destination it is delivered by, e.g. "2.json"
+	TicksLeft   int64   `json:"ticks_left"`  // Game time left before the deadline
+	Reward      float64 `json:"reward"`
+	XP          int     `json:"xp"`
+}
+
+// Destination is an exit a shop can send deliveries to
+type Destination struct {
+	Name  string  // Exit destination
+	Miles float64 // Road distance from the shop
+}
+
+// Offers returns the contracts on a shop's board. The same seed always gives the same board.
+func Offers(seed int64, destinations []Destination) []Contract {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	offers := make([]Contract, 0, OffersPerBoard)
+	for i := 0; i < OffersPerBoard; i++ {
+		dest := destinations[rng.Intn(len(destinations))]
+		c := Contract{
+			ID:          fmt.Sprintf("%x-%d", seed, i),
+			Kind:        KindParcel,
+			Destination: dest.Name,
+			Reward:      parcelReward + parcelPerMile*dest.Miles,
+			XP:          progression.XPPerDelivery,
+		}
+		mph := parcelMPH
+		if rng.Intn(3) == 0 {
+			c.Kind = KindFragile
+			c.Reward = fragileReward + fragilePerMile*dest.Miles
+			c.XP *= fragileXPMultiple
+			mph = fragileMPH
+		}
+
+		// Deadlines are rounded up to the next 10 seconds
+		seconds := dest.Miles/mph*3600 + slackSeconds
+		c.TicksLeft = int64(math.Ceil(seconds/10)*10) * ticksPerSecond
+		c.Reward = math.Round(c.Reward)
+		offers = append(offers, c)
+	}
+	return offers
+}
+
+// Title describes the contract, e.g. "PARCEL TO 2"
+func (c Contract) Title() string {
+	name := strings.TrimSuffix(filepath.Base(c.Destination), filepath.Ext(c.Destination))
+	if c.Kind == KindFragile {
+		return "FRAGILE CARGO TO " + name + " - NO CRASHES"
+	}
+	return "PARCEL TO " + name
+}
+
+// Countdown formats the time left as m:ss
+func (c Contract) Countdown() string {
+	seconds := max(0, (c.TicksLeft+ticksPerSecond-1)/ticksPerSecond)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// Expired reports whether the deadline has passed
+func (c Contract) Expired() bool {
+	return c.TicksLeft <= 0
+}
@@ -0,0 +1,173 @@
+// Package economy holds the game's prices and the player's wallet.
+// Every purchase and payout goes through a Wallet so the balance can never go negative.
+package economy
+
+import (
+	"hash/fnv"
+	"math"
+
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/road"
+)
+
+// Fuel prices, per litre
+const (
+	BaseFuelPrice     = 1.50
+	fuelPriceSpread   = 0.40 // Stations charge between BaseFuelPrice-15% and BaseFuelPrice+25%
+	fuelPriceDiscount = 0.15
+)
+
+// FuelGrade is a grade of petrol sold at the pumps. Its price is the station's price scaled by PriceFactor.
+type FuelGrade struct {
+	Name        string
+	PriceFactor float64
+}
+
+// FuelGrades lists the grades every petrol station sells, cheapest first
+var FuelGrades = []FuelGrade{
+	{Name: "UNLEADED", PriceFactor: 1.00},
+	{Name: "SUPER UNLEADED", PriceFactor: 1.10},
+	{Name: "PREMIUM", PriceFactor: 1.25},
+}
+
+// GradePrice returns the price per litre of grade at a station charging stationPrice for unleaded
+func GradePrice(stationPrice float64, grade FuelGrade) float64 {
+	return roundCents(stationPrice * grade.PriceFactor)
+}
+
+// Service prices, per point of the 0-100 need meter they refill
+const (
+	FoodPricePerPoint    = 0.20 // A full meal is $20
+	HotelPricePerPoint   = 1.00 // A full night is $100
+	MotelPricePerPoint   = 0.60
+	CampingPricePerPoint = 0.25
+)
+
+// Repair prices, for fixing a fully damaged component
+const (
+	BodyRepairPrice      = 800.00
+	AlignmentRepairPrice = 300.00
+	EngineRepairPrice    = 1500.00
+	BrakeRepairPrice     = 400.00 // New pads for fully worn brakes
+)
+
+// Breakdown services, for when the tank runs dry
+const (
+	JerryCanPrice      = 15.00
+	JerryCanLitres     = 5.0
+	RecoveryTruckPrice = 150.00 // Tow to the nearest petrol station
+)
+
+// Earnings
+const (
+	IncomePerMile      = 0.50
+	IncomePerCarPassed = 1.00
+)
+
+// FuelPrice returns the price per litre at a station. The same key always gives the same price,
+// so a station keeps its price for the whole level (and across restarts).
+func FuelPrice(stationKey string) float64 {
+	h := fnv.New32a()
+	h.Write([]byte(stationKey))
+	spread := float64(h.Sum32()%1000) / 1000.0
+
+	return roundCents(BaseFuelPrice * (1 - fuelPriceDiscount + spread*fuelPriceSpread))
+}
+
+// SleepPricePerPoint returns what a layby service charges per point of sleep, or 0 if it offers no beds
+func SleepPricePerPoint(serviceType int) float64 {
+	switch serviceType {
+	case road.ServiceTypeHotel:
+		return HotelPricePerPoint
+	case road.ServiceTypeMotel:
+		return MotelPricePerPoint
+	case road.ServiceTypeCampground, road.ServiceTypeRVPark, road.ServiceTypeCamping:
+		return CampingPricePerPoint
+	default:
+		return 0
+	}
+}
+
+// RepairBill returns what it costs to fix all of a car's damage and brake wear
+func RepairBill(c *car.Car) float64 {
+	brakeWear := (car.NewBrakeCondition - c.Brakes.Condition) / (car.NewBrakeCondition - car.WornBrakeCondition)
+	return roundCents(c.Damage.Body*BodyRepairPrice +
+		math.Abs(c.Damage.Alignment)*AlignmentRepairPrice +
+		c.Damage.Engine*EngineRepairPrice +
+		max(0, brakeWear)*BrakeRepairPrice)
+}
+
+// roundCents rounds an amount to whole cents
+func roundCents(amount float64) float64 {
+	return float64(int64(amount*100+0.5)) / 100
+}
+
+// Wallet is the player's money, with running totals of what came in and went out
+type Wallet struct {
+	Balance float64
+	Earned  float64
+	Spent   float64
+}
+
+// NewWallet creates a wallet holding balance
+func NewWallet(balance float64) *Wallet {
+	return &Wallet{Balance: balance}
+}
+
+// Charge takes amount from the wallet. It returns false and charges nothing if the balance is too low.
+func (w *Wallet) Charge(amount float64) bool {
+	if amount <= 0 {
+		return true
+	}
+	if amount > w.Balance {
+		return false
+	}
+	w.Balance -= amount
+	w.Spent += amount
+	return true
+}
+
+// ChargeUpTo takes as much of amount as the wallet can afford and returns what was taken.
+// Used for bills that cannot be refused, such as repairs.
+func (w *Wallet) ChargeUpTo(amount float64) float64 {
+	if amount <= 0 {
+		return 0
+	}
+	taken := min(amount, w.Balance)
+	w.Balance -= taken
+	w.Spent += taken
+	return taken
+}
+
+// Earn adds amount to the wallet
+func (w *Wallet) Earn(amount float64) {
+	if amount <= 0 {
+		return
+	}
+	w.Balance += amount
+	w.Earned += amount
+}
+
+// Car prices: a base price by category plus a price per BHP
+var carCategoryPrices = map[string]float64{
+	"C1": 3000,
+	"C2": 8000,
+	"C3": 20000,
+	"C4": 50000,
+	"C5": 120000,
+}
+
+const (
+	carPricePerBHP    = 25.0
+	carResaleFraction = 0.6 // Dealers buy cars back for 60% of their price
+)
+
+// CarPrice returns what a dealer sells a car for
+func CarPrice(c *car.Car) float64 {
+	return roundCents(carCategoryPrices[c.Category] + float64(c.BHP)*carPricePerBHP)
+}
+
+// CarResalePrice returns what a dealer pays for a car. Unrepaired damage comes off the price.
+func CarResalePrice(c *car.Car) float64 {
+	return max(0, roundCents(CarPrice(c)*carResaleFraction-RepairBill(c)))
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+)
+
+// severeCrashMPH is the closing speed at which a crash is severe enough to respawn the player
+const severeCrashMPH = 40.0
+
+// respawnGraceTicks is how long after a respawn crashes are not counted (2 seconds at 60 FPS)
+const respawnGraceTicks = 120
+
+// Checkpoint is where the player respawns after a crash, with the stats they had on reaching it
+type Checkpoint struct {
+	Segment           int     `json:"segment"` // Index of the layby segment; -1 for the start of the level
+	X                 float64 `json:"x"`
+	Y                 float64 `json:"y"`
+	DistanceTravelled float64 `json:"distance_travelled"`
+	TotalCarsPassed   int     `json:"total_cars_passed"`
+}
+
+// levelStartCheckpoint is the checkpoint in force until the first layby is reached.
+// It carries the stats the session started with.
+func (gs *GameplayScreen) levelStartCheckpoint() Checkpoint {
+	return Checkpoint{
+		Segment:           -1,
+		X:                 gs.initialX,
+		Y:                 gs.initialY,
+		DistanceTravelled: gs.sessionStartDistance,
+		TotalCarsPassed:   gs.sessionStartCarsPassed,
+	}
+}
+
+// updateCheckpoint records a new checkpoint when the player drives past the start of a layby
+func (gs *GameplayScreen) updateCheckpoint(segmentIdx int) {
+	for _, layby := range gs.levelData.Checkpoints {
+		if layby <= gs.checkpoint.Segment || layby != segmentIdx || layby >= len(gs.roadSegments) {
+			continue
+		}
+
+		// Respawn parked in the layby lane, clear of traffic (or in the starting lane if the layby was trimmed)
+		segment := gs.roadSegments[layby]
+		laneWidth := 80.0
+		leftEdge := -float64(segment.StartLaneIndex) * laneWidth
+		x := leftEdge + laneWidth/2
+		if len(segment.LanePositions) == 0 || segment.LanePositions[0] != 0 {
+			x = leftEdge + float64(segment.StartLaneIndex)*laneWidth + laneWidth/2
+		}
+
+		gs.checkpoint = Checkpoint{
+			Segment:           layby,
+			X:                 x,
+			Y:                 segment.Y - 300,
+			DistanceTravelled: gs.DistanceTravelled,
+			TotalCarsPassed:   gs.TotalCarsPassed,
+		}
+		gs.showToast("CHECKPOINT")
+		gs.recordSplit()
+		return
+	}
+}
+
+// shouldRespawn decides whether a crash at the given closing speed sends the player back to the checkpoint
+func (gs *GameplayScreen) shouldRespawn(impactMPH float64) bool {
+	switch gs.difficulty {
+	case profile.DifficultyEasy:
+		return true
+	case profile.DifficultyHard:
+		return false
+	default:
+		return impactMPH >= severeCrashMPH
+	}
+}
+
+// respawnAtCheckpoint puts the player back at the last checkpoint. Distance and cars passed since
+// the checkpoint are lost; crashes, XP, needs and fuel are kept.
+func (gs *GameplayScreen) respawnAtCheckpoint() {
+	cp := gs.checkpoint
+
+	gs.DistanceTravelled = cp.DistanceTravelled
+	gs.TotalCarsPassed = cp.TotalCarsPassed
+
+	gs.moveToRespawn(cp.X, cp.Y)
+	gs.respawnGraceUntil = gs.ticks + respawnGraceTicks
+	gs.showToast("RESPAWNED AT CHECKPOINT")
+}
+
+// moveToRespawn stops the player at x, y and replaces the traffic around them.
+// Cars near the respawn point are removed, then fresh traffic is spawned at a safe distance.
+func (gs *GameplayScreen) moveToRespawn(x, y float64) {
+	gs.playerCar.X = x
+	gs.playerCar.Y = y
+	gs.playerCar.Speed = 0
+	gs.playerCar.VelocityX = 0
+	gs.playerCar.VelocityY = 0
+	gs.playerCar.SteeringAngle = 0
+	gs.autoDrive = false
+	gs.onFoot = false
+	gs.playerPed = nil
+
+	gs.cameraX = x - float64(gs.screenWidth)/2
+	gs.cameraY = y - float64(gs.screenHeight)/2
+
+	gs.trafficMutex.Lock()
+	kept := make([]*TrafficCar, 0, len(gs.traffic))
+	for _, tc := range gs.traffic {
+		if tc.Y < y-trafficSpawnRange || tc.Y > y+trafficSpawnRange {
+			kept = append(kept, tc)
+		}
+	}
+	gs.traffic = kept
+	gs.trafficMutex.Unlock()
+
+	gs.spawnInitialTraffic()
+	gs.lastSpawnTime = gs.nowMillis()
+}
+
+// cycleDifficulty switches to the next difficulty setting
+func (gs *GameplayScreen) cycleDifficulty() {
+	next := 0
+	for i, difficulty := range profile.Difficulties {
+		if difficulty == gs.difficulty {
+			next = (i + 1) % len(profile.Difficulties)
+			break
+		}
+	}
+	gs.difficulty = profile.Difficulties[next]
+	gs.showToast("DIFFICULTY: %s", strings.ToUpper(gs.difficulty))
+}
@@ -0,0 +1,148 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/contracts"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// milesPerPixel converts road distance in world pixels to miles, as the trip meter counts them
+const milesPerPixel = MPHPerPixelPerFrame / 216000.0
+
+// offerContracts opens the shop's contracts board when the player presses C while stopped at station i
+func (gs *GameplayScreen) offerContracts(i int, station PetrolStation) {
+	if !inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		return
+	}
+
+	// A shop's board stays the same until the player next delivers something
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%d/%d", gs.levelData.Name, i, gs.deliveriesCompleted)
+	offers := contracts.Offers(int64(h.Sum64()), gs.contractDestinations(station))
+
+	// Contracts already taken are off the board
+	available := offers[:0]
+	for _, offer := range offers {
+		if !gs.hasContract(offer.ID) {
+			available = append(available, offer)
+		}
+	}
+
+	onAccept := func(c contracts.Contract) bool {
+		if len(gs.activeContracts) >= contracts.MaxActive || gs.hasContract(c.ID) {
+			return false
+		}
+		gs.activeContracts = append(gs.activeContracts, c)
+		gs.showToast("CONTRACT TAKEN: %s", c.Title())
+		return true
+	}
+	onExit := func() {
+		gs.contractBoard = nil
+	}
+	gs.contractBoard = ui.NewContractBoardScreen(available, len(gs.activeContracts), onAccept, onExit)
+}
+
+// contractDestinations lists the exits further up the level than station, which its shop can deliver to
+func (gs *GameplayScreen) contractDestinations(station PetrolStation) []contracts.Destination {
+	var destinations []contracts.Destination
+	for _, exit := range gs.levelData.Exits {
+		if exit.Segment >= len(gs.roadSegments) || exit.Destination == "" {
+			continue
+		}
+		distance := station.Y - gs.roadSegments[exit.Segment].Y
+		if distance <= 0 {
+			continue
+		}
+		destinations = append(destinations, contracts.Destination{Name: exit.Destination, Miles: distance * milesPerPixel})
+	}
+	return destinations
+}
+
+// hasContract reports whether the player carries the contract with this ID
+func (gs *GameplayScreen) hasContract(id string) bool {
+	for _, c := range gs.activeContracts {
+		if c.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// canOfferContracts reports whether the player is stopped at a shop
+func (gs *GameplayScreen) canOfferContracts() bool {
+	if gs.atStation < 0 || gs.atStation >= len(gs.petrolStations) {
+		return false
+	}
+	return gs.petrolStations[gs.atStation].ServiceType == road.ServiceTypeShop
+}
+
+// updateContracts counts down the contracts' deadlines and drops the ones that run out
+func (gs *GameplayScreen) updateContracts() {
+	kept := gs.activeContracts[:0]
+	for _, c := range gs.activeContracts {
+		c.TicksLeft--
+		if c.Expired() {
+			gs.showToast("CONTRACT FAILED - OUT OF TIME: %s", c.Title())
+			continue
+		}
+		kept = append(kept, c)
+	}
+	gs.activeContracts = kept
+}
+
+// breakFragileCargo fails every fragile contract; called on a crash
+func (gs *GameplayScreen) breakFragileCargo() {
+	kept := gs.activeContracts[:0]
+	for _, c := range gs.activeContracts {
+		if c.Kind == contracts.KindFragile {
+			gs.showToast("CONTRACT FAILED - CARGO DAMAGED: %s", c.Title())
+			continue
+		}
+		kept = append(kept, c)
+	}
+	gs.activeContracts = kept
+}
+
+// deliverContracts pays for every contract bound for the exit the player has just taken
+func (gs *GameplayScreen) deliverContracts(destination string) {
+	kept := gs.activeContracts[:0]
+	for _, c := range gs.activeContracts {
+		if c.Destination != destination {
+			kept = append(kept, c)
+			continue
+		}
+		gs.wallet.Earn(c.Reward)
+		gs.awardXP(c.XP)
+		gs.deliveriesCompleted++
+		gs.showToast("DELIVERED! +$%.0f: %s", c.Reward, c.Title())
+	}
+	gs.activeContracts = kept
+}
+
+// drawContracts lists the contracts being carried, with their countdowns, under the speedometer
+func (gs *GameplayScreen) drawContracts(screen *ebiten.Image) {
+	if len(gs.activeContracts) == 0 {
+		return
+	}
+	face := text.NewGoXFace(bitmapfont.Face)
+	x, y := 20.0, 195.0
+
+	for i, c := range gs.activeContracts {
+		clr := color.RGBA{255, 255, 255, 255}
+		if c.TicksLeft < 30*60 {
+			clr = color.RGBA{255, 80, 80, 255} // Under 30 seconds left
+		}
+		op := &text.DrawOptions{}
+		op.GeoM.Translate(x, y+float64(i)*20)
+		op.ColorScale.ScaleWithColor(clr)
+		text.Draw(screen, c.Countdown()+"  "+c.Title(), face, op)
+	}
+}
@@ -0,0 +1,140 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/licence"
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+)
+
+// starterCategory is the category of the car every new profile is given
+const starterCategory = "C1"
+
+var (
+	errNotEnoughMoney = errors.New("NOT ENOUGH MONEY")
+	errLastCar        = errors.New("YOU CAN'T SELL YOUR ONLY CAR")
+	errNoProfile      = errors.New("NO PROFILE LOADED")
+	errAlreadyFitted  = errors.New("ALREADY FITTED")
+	errNotSuspended   = errors.New("YOUR LICENCE IS NOT SUSPENDED")
+)
+
+// giveStarterCar gives p the cheapest starter category car if it owns none, and selects it
+func giveStarterCar(p *profile.PlayerProfile) {
+	if len(p.OwnedCars) > 0 {
+		return
+	}
+
+	var starter *car.Car
+	for _, c := range models.CarInventory.GetAllCars() {
+		if c.Category != starterCategory {
+			continue
+		}
+		if starter == nil || economy.CarPrice(c) < economy.CarPrice(starter) {
+			starter = c
+		}
+	}
+	if starter == nil {
+		starter = models.CarInventory.GetAllCars()[0]
+	}
+
+	// The profile gets its own copy of the car so fuel use is not shared with the catalogue
+	p.CurrentCarIndex = p.AddCar(starter.Clone())
+	log.Printf("Gave %s a starter %s %s", p.Name, starter.Make, starter.Model)
+}
+
+// BuyCar buys a copy of the catalogue car c for the current profile
+func (g *GameLogic) BuyCar(c *car.Car) error {
+	p := g.currentProfile
+	if p == nil {
+		return errNoProfile
+	}
+
+	if required := progression.CarLevel(c); !progression.Unlocked(required, p.Level) {
+		return lockedError(required)
+	}
+	price := economy.CarPrice(c)
+	if price > p.Money {
+		return errNotEnoughMoney
+	}
+	p.Money -= price
+	p.AddCar(c.Clone())
+	g.SaveCurrentProfile()
+	return nil
+}
+
+// SellCar sells the current profile's car at index back to the dealer.
+// The last car cannot be sold, so the player is never left with nothing to drive.
+func (g *GameLogic) SellCar(index int) error {
+	p := g.currentProfile
+	if p == nil {
+		return errNoProfile
+	}
+	if len(p.OwnedCars) <= 1 {
+		return errLastCar
+	}
+
+	wasDriving := index == p.CurrentCarIndex
+	sold := p.RemoveCar(index)
+	if sold == nil {
+		return nil
+	}
+	p.Money += economy.CarResalePrice(sold)
+	if wasDriving {
+		// A mid-level save would put the sold car back on the road
+		g.DeleteSnapshot()
+	}
+	g.SaveCurrentProfile()
+	return nil
+}
+
+// BuyPart buys part and fits it to c, one of the current profile's cars. The part it replaces is scrapped.
+func (g *GameLogic) BuyPart(c *car.Car, part car.Part) error {
+	p := g.currentProfile
+	if p == nil {
+		return errNoProfile
+	}
+	if c.HasPart(part) {
+		return errAlreadyFitted
+	}
+	if required := progression.PartLevel(part); !progression.Unlocked(required, p.Level) {
+		return lockedError(required)
+	}
+	if part.Price > p.Money {
+		return errNotEnoughMoney
+	}
+
+	p.Money -= part.Price
+	c.Fit(part)
+	g.SaveCurrentProfile()
+	return nil
+}
+
+// TakeCourse charges the current profile for the driver improvement course, which lifts a licence suspension
+func (g *GameLogic) TakeCourse() error {
+	p := g.currentProfile
+	if p == nil {
+		return errNoProfile
+	}
+	if !p.Licence.Suspended {
+		return errNotSuspended
+	}
+	if licence.CourseFee > p.Money {
+		return errNotEnoughMoney
+	}
+
+	p.Money -= licence.CourseFee
+	p.Licence.CompleteCourse()
+	g.SaveCurrentProfile()
+	return nil
+}
+
+// lockedError is returned for something the player has not reached the level for yet
+func lockedError(level int) error {
+	return fmt.Errorf("UNLOCKS AT LEVEL %d", level)
+}
@@ -1,26 +1,48 @@
 package game
 
 import (
-	"encoding/json"
+	"fmt"
 	"log"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/leaderboard"
 	"github.com/golangdaddy/roadster/pkg/models"
 	"github.com/golangdaddy/roadster/pkg/models/car"
 	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
 	"github.com/golangdaddy/roadster/pkg/road"
 	"github.com/golangdaddy/roadster/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// legacySaveFile is where saves were written before profiles were stored per user
+const legacySaveFile = "save.json"
+
 type GameLogic struct {
-	levels    []*road.RoadController
-	levelData []*LevelData
-	
+	levels     []*road.RoadController
+	levelData  []*LevelData
+	levelFiles []string
+
+	// Hot-reload of level files
+	levelWatcher  *levelWatcher
+	reloadErrs    map[string]error // Level files whose last reload failed, by path
+	reloadErrTime time.Time        // When a reload last failed
+	reloadBanner  *ebiten.Image
+
 	// Profile Management
+	profileStore   *profile.Store
 	profiles       []*profile.PlayerProfile
 	currentProfile *profile.PlayerProfile
+
+	// Local top scores, kept next to the profiles
+	leaderboards *leaderboard.Leaderboards
 }
 
 func (g *GameLogic) Levels() []*road.RoadController {
@@ -50,15 +72,255 @@ func (g *GameLogic) CurrentProfile() *profile.PlayerProfile {
 	return g.currentProfile
 }
 
+// LoadProfiles refreshes the profile list from the profile store
+func (g *GameLogic) LoadProfiles() []*profile.PlayerProfile {
+	if g.profileStore == nil {
+		return g.profiles
+	}
+
+	profiles, err := g.profileStore.List()
+	if err != nil {
+		log.Printf("Failed to list profiles: %v", err)
+		return g.profiles
+	}
+	g.profiles = profiles
+	return g.profiles
+}
+
+// SaveCurrentProfile writes the current profile to the profile store
+func (g *GameLogic) SaveCurrentProfile() {
+	if g.profileStore == nil || g.currentProfile == nil {
+		return
+	}
+	if err := g.profileStore.Save(g.currentProfile); err != nil {
+		log.Printf("Failed to save profile %s: %v", g.currentProfile.ID, err)
+	}
+}
+
+// SaveSnapshot stores a mid-level save for the current profile, replacing any earlier one
+func (g *GameLogic) SaveSnapshot(snap *Snapshot) error {
+	if g.profileStore == nil || g.currentProfile == nil {
+		return fmt.Errorf("no profile to save to")
+	}
+	if snap.LevelIndex >= 0 && snap.LevelIndex < len(g.levelFiles) {
+		snap.LevelFile = g.levelFiles[snap.LevelIndex]
+	}
+
+	path, err := g.profileStore.SnapshotPath(g.currentProfile.ID)
+	if err != nil {
+		return err
+	}
+	return writeSnapshot(path, snap)
+}
+
+// LoadSnapshot returns the current profile's mid-level save, or nil if it has none
+func (g *GameLogic) LoadSnapshot() *Snapshot {
+	if g.profileStore == nil || g.currentProfile == nil {
+		return nil
+	}
+	path, err := g.profileStore.SnapshotPath(g.currentProfile.ID)
+	if err != nil {
+		return nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil
+	}
+
+	snap, err := readSnapshot(path)
+	if err != nil {
+		log.Printf("Ignoring mid-level save for %s: %v", g.currentProfile.ID, err)
+		return nil
+	}
+	return snap
+}
+
+// DeleteSnapshot removes the current profile's mid-level save
+func (g *GameLogic) DeleteSnapshot() {
+	if g.profileStore == nil || g.currentProfile == nil {
+		return
+	}
+	path, err := g.profileStore.SnapshotPath(g.currentProfile.ID)
+	if err != nil {
+		return
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("Failed to delete mid-level save for %s: %v", g.currentProfile.ID, err)
+	}
+}
+
+// SubmitScore puts a finished run by p on its level and mode's leaderboard and saves it.
+// It returns the run's place on the table, or 0 if it did not make it. Quit runs are not entered.
+// A time trial is entered only if it reached the end; ghost is its recording, kept while it stays on the table.
+func (g *GameLogic) SubmitScore(p *profile.PlayerProfile, c *car.Car, results ui.RunResults, ghost *Ghost) int {
+	if g.leaderboards == nil || results.Outcome == ui.RunQuit {
+		return 0
+	}
+
+	entry := leaderboard.Entry{
+		PlayerName:   p.Name,
+		HeadshotPath: p.HeadshotPath,
+		Score:        results.Score,
+		Time:         results.Duration,
+		Miles:        results.Miles,
+		Car:          c.Make + " " + c.Model,
+		Date:         time.Now(),
+	}
+	if results.TimeTrial {
+		if ghost == nil {
+			return 0
+		}
+		entry.Time = results.TrialTime
+		entry.Replay = g.saveGhost(ghost)
+	} else if results.Taxi {
+		// Taxi tables rank the shift's takings
+		entry.Score = int(math.Round(results.Fares))
+		if entry.Score <= 0 {
+			return 0
+		}
+	} else if results.Score <= 0 {
+		return 0
+	}
+
+	previous := slices.Clone(g.leaderboards.Top(results.LevelName, results.Mode))
+	rank := g.leaderboards.Submit(results.LevelName, results.Mode, modeOrder(results.Mode), entry)
+	g.pruneGhosts(append(previous, entry), g.leaderboards.Top(results.LevelName, results.Mode))
+
+	if err := g.leaderboards.Save(); err != nil {
+		log.Printf("Failed to save leaderboard: %v", err)
+	}
+	return rank
+}
+
+// LoadGhost returns the quickest recorded time trial on a level, or nil if there is none
+func (g *GameLogic) LoadGhost(levelName string) *Ghost {
+	if g.profileStore == nil {
+		return nil
+	}
+	for _, e := range g.Leaderboard(levelName, ModeTimeTrial) {
+		if e.Replay == "" {
+			continue
+		}
+		path, err := g.profileStore.GhostPath(e.Replay)
+		if err != nil {
+			continue
+		}
+		ghost, err := readGhost(path)
+		if err != nil {
+			log.Printf("Ignoring ghost %s: %v", e.Replay, err)
+			continue
+		}
+		return ghost
+	}
+	return nil
+}
+
+// saveGhost writes a time trial recording and returns the name the leaderboard refers to it by, or "" if it could not be saved
+func (g *GameLogic) saveGhost(ghost *Ghost) string {
+	if g.profileStore == nil {
+		return ""
+	}
+	name := fmt.Sprintf("%s-%d.json", ghost.Level, time.Now().UnixNano())
+	path, err := g.profileStore.GhostPath(name)
+	if err == nil {
+		err = writeGhost(path, ghost)
+	}
+	if err != nil {
+		log.Printf("Failed to save ghost for level %s: %v", ghost.Level, err)
+		return ""
+	}
+	return name
+}
+
+// pruneGhosts deletes the recordings of entries that are no longer on table
+func (g *GameLogic) pruneGhosts(entries, table []leaderboard.Entry) {
+	for _, e := range entries {
+		if e.Replay == "" || slices.ContainsFunc(table, func(kept leaderboard.Entry) bool { return kept.Replay == e.Replay }) {
+			continue
+		}
+		path, err := g.profileStore.GhostPath(e.Replay)
+		if err != nil {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Printf("Failed to delete ghost %s: %v", e.Replay, err)
+		}
+	}
+}
+
+// Leaderboard returns the top runs on a level in a mode, best first
+func (g *GameLogic) Leaderboard(levelName, mode string) []leaderboard.Entry {
+	if g.leaderboards == nil {
+		return nil
+	}
+	return g.leaderboards.Top(levelName, mode)
+}
+
+// levelIndexByName finds a level by file path or by file name (as used by exit destinations), or returns -1
+func (g *GameLogic) levelIndexByName(name string) int {
+	for i, file := range g.levelFiles {
+		if file == name || path.Base(file) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// levelUnlocked reports whether the current profile has reached the player level needed to drive the level at index.
+// Without a profile (a replayed snapshot) every level is open.
+func (g *GameLogic) levelUnlocked(index int) bool {
+	if index < 0 || index >= len(g.levelData) {
+		return false
+	}
+	if g.currentProfile == nil {
+		return true
+	}
+	return progression.Unlocked(g.levelData[index].RequiredLevel, g.currentProfile.Level)
+}
+
+// nextLevelIndex picks the level that follows a completed one: the exit the player took,
+// else the level's first exit destination, else the next level file. Returns -1 if there is none.
+func (g *GameLogic) nextLevelIndex(levelIndex int, exitTaken string) int {
+	if exitTaken != "" {
+		return g.levelIndexByName(exitTaken)
+	}
+
+	if levelIndex >= 0 && levelIndex < len(g.levelData) {
+		for _, exit := range g.levelData[levelIndex].Exits {
+			if next := g.levelIndexByName(exit.Destination); next >= 0 {
+				return next
+			}
+		}
+	}
+
+	if levelIndex+1 < len(g.levelData) {
+		return levelIndex + 1
+	}
+	return -1
+}
+
+// snapshotLevelIndex finds the level a snapshot was taken on, following the level file if the list has changed
+func (g *GameLogic) snapshotLevelIndex(snap *Snapshot) int {
+	for i, file := range g.levelFiles {
+		if snap.LevelFile != "" && file == snap.LevelFile {
+			return i
+		}
+	}
+	if snap.LevelIndex >= 0 && snap.LevelIndex < len(g.levelData) {
+		return snap.LevelIndex
+	}
+	return 0
+}
+
 func (game *GameLogic) LoadLevels() error {
 	// Find all level files
-	levelFiles, err := filepath.Glob("assets/level/*.json")
+	levelFiles, err := assets.Default.LevelFiles()
 	if err != nil {
 		return err
 	}
 
 	game.levels = make([]*road.RoadController, 0, len(levelFiles))
 	game.levelData = make([]*LevelData, 0, len(levelFiles))
+	game.levelFiles = make([]string, 0, len(levelFiles))
 
 	for _, levelFile := range levelFiles {
 		roadController, levelData, err := game.loadLevel(levelFile)
@@ -67,6 +329,7 @@ func (game *GameLogic) LoadLevels() error {
 		}
 		game.levels = append(game.levels, roadController)
 		game.levelData = append(game.levelData, levelData)
+		game.levelFiles = append(game.levelFiles, levelFile)
 	}
 
 	return nil
@@ -74,7 +337,26 @@ func (game *GameLogic) LoadLevels() error {
 
 // LevelData represents the parsed level information for rendering
 type LevelData struct {
-	Segments []RoadSegment
+	Name          string // Level file name without extension (e.g. "1")
+	RequiredLevel int    // Player level needed to drive it
+	Segments      []RoadSegment
+	Exits         []LevelExit
+	Checkpoints   []int // Segment index at the start of each layby
+	Services      []LevelService
+	SpeedCameras  []int // Segment index of each speed camera gantry
+}
+
+// LevelService is a service (road.ServiceType*) offered on a layby segment.
+// Layby segments without one (the padding before an on-ramp) sell petrol.
+type LevelService struct {
+	Segment int
+	Type    int
+}
+
+// LevelExit is an exit slip road out of a level
+type LevelExit struct {
+	Segment     int    // Index of the "G" segment the exit leaves from
+	Destination string // Level file the exit leads to (e.g. "2.json")
 }
 
 // RoadSegment represents a segment of road with its type and lane count
@@ -87,39 +369,38 @@ type RoadSegment struct {
 }
 
 func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelData, error) {
-	file, err := os.Open(filename)
+	// Parse JSON level definition
+	levelDef, err := assets.Default.Level(filename)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer file.Close()
 
 	roadController := road.NewRoadController()
 	levelData := &LevelData{
-		Segments: make([]RoadSegment, 0),
-	}
-
-	// Parse JSON level definition
-	var levelDef road.LevelDefinition
-	if err := json.NewDecoder(file).Decode(&levelDef); err != nil {
-		return nil, nil, err
+		Name:          strings.TrimSuffix(path.Base(filename), path.Ext(filename)),
+		RequiredLevel: max(1, levelDef.RequiredLevel),
+		Segments:      make([]RoadSegment, 0),
+		Exits:         make([]LevelExit, 0),
 	}
+	levelData.Checkpoints = make([]int, 0)
+	levelData.Services = make([]LevelService, 0)
 
 	// Reconstruct lines from Layout and Sections
 	reconstructedLines := make([]string, 0)
-	
+
 	// Import strings for Repeat function if not already imported
 	// Since we can't see imports here, we assume "strings" is needed.
-	
+
 	lastLaneCount := 0
-	
+
 	for _, sectionName := range levelDef.Layout {
 		if section, ok := levelDef.Sections[sectionName]; ok {
 			for _, seg := range section.Segments {
 				currentLaneCount := len(seg)
-				
+
 				// Auto-insert Transition Segment if lane count increases by 1
 				if lastLaneCount > 0 {
-					if currentLaneCount == lastLaneCount + 1 {
+					if currentLaneCount == lastLaneCount+1 {
 						// LANE INCREASES (On-ramp D)
 						// Create transition segment: previous lanes + "D"
 						transition := ""
@@ -127,10 +408,10 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 							transition += "A"
 						}
 						transition += "D"
-						
+
 						// Initialize with "X" + transition to assume empty lane 0
 						reconstructedLines = append(reconstructedLines, "X"+transition)
-					} else if currentLaneCount == lastLaneCount - 1 {
+					} else if currentLaneCount == lastLaneCount-1 {
 						// LANE DECREASES (Off-ramp E)
 						// Create transition segment: current lanes + "E"
 						// "E" represents the lane that is ending (merging left)
@@ -139,14 +420,14 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 							transition += "A"
 						}
 						transition += "E"
-						
+
 						reconstructedLines = append(reconstructedLines, "X"+transition)
 					}
 				}
-				
+
 				// Initialize with "X" + segment to assume empty lane 0
 				reconstructedLines = append(reconstructedLines, "X"+seg)
-				
+
 				lastLaneCount = currentLaneCount
 			}
 		}
@@ -169,13 +450,14 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 		// Auto-insert On-ramp/Off-ramp logic
 		// We need to look at the segment to decide what letters to put where.
 		// Assuming originalSegment defines the main road.
-		
+
 		// We need to prepend the special characters to the original segment string.
 		// This effectively adds a lane to the left.
 		// e.g. "AAA" -> "BAAA" (Off-ramp)
-		
+
 		// Start of layby (Off-ramp - B)
 		reconstructedLines[idx] = "B" + originalSegment
+		levelData.Checkpoints = append(levelData.Checkpoints, idx)
 		idx++
 
 		// Services
@@ -189,8 +471,8 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 			// Map service type to character
 			char := "F" // Default to Petrol/Services (F)
 			// TODO: Add mapping for other service types when textures exist
-			// For now we just use F regardless of service.Type
-			_ = service
+			// For now every service is drawn as F; what it sells is kept in Services
+			levelData.Services = append(levelData.Services, LevelService{Segment: idx, Type: service.Type})
 
 			reconstructedLines[idx] = char + originalSegment
 			idx++
@@ -202,6 +484,7 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 			paddingChar := "F" // Default to F (petrol/services) if no exit destination
 			if layby.ExitDestination != "" {
 				paddingChar = "G" // Use G only when exit_destination is set
+				levelData.Exits = append(levelData.Exits, LevelExit{Segment: idx, Destination: layby.ExitDestination})
 			}
 			reconstructedLines[idx] = paddingChar + originalSegment
 			idx++
@@ -214,6 +497,13 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 		}
 	}
 
+	// Speed cameras sit on segments of the finished road
+	for _, camera := range levelDef.SpeedCameras {
+		if camera.Segment >= 0 && camera.Segment < len(reconstructedLines) {
+			levelData.SpeedCameras = append(levelData.SpeedCameras, camera.Segment)
+		}
+	}
+
 	// Process reconstructed lines using existing logic
 	for _, line := range reconstructedLines {
 		if line == "" {
@@ -272,6 +562,10 @@ func (game *GameLogic) loadLevel(filename string) (*road.RoadController, *LevelD
 		levelData.Segments = append(levelData.Segments, segment)
 	}
 
+	if len(levelData.Segments) == 0 {
+		return nil, nil, fmt.Errorf("%s: level has no road segments", filename)
+	}
+
 	return roadController, levelData, nil
 }
 
@@ -295,36 +589,53 @@ func NewGame() *Game {
 		},
 	}
 
+	// Profiles are saved in the per-user data directory
+	if dir, err := profile.DefaultDir(); err != nil {
+		log.Printf("Failed to locate profile directory: %v", err)
+	} else if store, err := profile.NewStore(dir); err != nil {
+		log.Printf("Failed to open profile store: %v", err)
+	} else {
+		game.gameLogic.profileStore = store
+
+		leaderboards, err := leaderboard.Load(leaderboard.DefaultPath(dir))
+		if err != nil {
+			log.Printf("Failed to load leaderboard: %v", err)
+		}
+		game.gameLogic.leaderboards = leaderboards
+
+		// Bring over the old single-slot save.json so it shows up under Load Game
+		if _, err := os.Stat(legacySaveFile); err == nil {
+			if p, imported, err := store.Import(legacySaveFile); err != nil {
+				log.Printf("Failed to import %s: %v", legacySaveFile, err)
+			} else if imported {
+				log.Printf("Imported %s as profile %s", legacySaveFile, p.ID)
+			}
+		}
+	}
+
 	// Initialize with title screen
-	game.currentScreen = ui.NewTitleScreen(func() {
-		// Transition to Character Selection Screen (New Game)
-		// TODO: Add Load Game screen later for multi-tenancy
-		game.currentScreen = ui.NewCharacterSelectionScreen(func(p *profile.PlayerProfile) {
-			// Profile created!
-			game.gameLogic.SetCurrentProfile(p)
-			
-			// Transition to loading screen
-			game.currentScreen = ui.NewLoadingScreen(func(gameState *models.GameState) {
-				// Transition to garage screen
-				game.currentScreen = ui.NewGarageScreen(func(selectedCar *car.Car) {
-					// Update profile with selected car
-					game.gameLogic.CurrentProfile().CurrentCar = selectedCar
-					
-					// Start the actual game with selected car
-					game.startGameplay(selectedCar)
-				})
-			})
-		})
-	})
+	game.showTitle()
 
 	// Load levels
 	if err := game.gameLogic.LoadLevels(); err != nil {
 		// Handle error - for now just continue
 		log.Printf("Failed to load levels: %v", err)
 	}
+	game.gameLogic.levelWatcher = newLevelWatcher(assets.Default.FS(), assets.LevelGlob)
+
+	// Load the XP curve and HUD switches
+	if rules, err := assets.Default.GameRules(); err == nil {
+		progression.Rules = rules
+	} else {
+		log.Printf("Failed to load game rules, using defaults: %v", err)
+	}
 
 	// Load car inventory
-	if err := models.CarInventory.LoadInventory("assets/car_data.json"); err != nil {
+	carData, err := assets.Default.CarData()
+	if err == nil {
+		err = models.CarInventory.LoadInventory(carData)
+	}
+	if err != nil {
 		log.Printf("Failed to load car inventory: %v", err)
 	}
 
@@ -333,6 +644,11 @@ func NewGame() *Game {
 
 // Update handles game logic updates
 func (g *Game) Update() error {
+	defer g.dumpCrashSnapshot()
+
+	// Pick up edits to level files while the game is running
+	g.checkLevelReload()
+
 	if g.currentScreen != nil {
 		return g.currentScreen.Update()
 	}
@@ -344,6 +660,9 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	if g.currentScreen != nil {
 		g.currentScreen.Draw(screen)
 	}
+
+	// Level reload errors are drawn on top of whatever screen is showing
+	g.drawReloadError(screen)
 }
 
 // Layout returns the game's screen dimensions
@@ -351,29 +670,279 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeigh
 	return 1024, 600 // Standard window size
 }
 
-// startGameplay transitions to the actual gameplay
-func (g *Game) startGameplay(selectedCar *car.Car) {
-	// Use the first level for now
+// showTitle shows the title screen, which leads to the New Game / Load Game menu
+func (g *Game) showTitle() {
+	g.currentScreen = ui.NewTitleScreen(func() {
+		g.currentScreen = ui.NewLoadingScreen(g.showNewGame, g.showLoadGame)
+	})
+}
+
+// showNewGame creates a profile via character selection and sends it to the garage
+func (g *Game) showNewGame() {
+	g.currentScreen = ui.NewCharacterSelectionScreen(func(p *profile.PlayerProfile) {
+		// Profile created!
+		giveStarterCar(p)
+		g.gameLogic.SetCurrentProfile(p)
+		g.gameLogic.SaveCurrentProfile()
+		g.showGarage()
+	})
+}
+
+// showLoadGame lists saved profiles and resumes the chosen one
+func (g *Game) showLoadGame() {
+	profiles := g.gameLogic.LoadProfiles()
+	g.currentScreen = ui.NewLoadGameScreen(profiles, func(p *profile.PlayerProfile) {
+		g.gameLogic.SetCurrentProfile(p)
+
+		// Saves from before owned cars may have no car at all
+		if len(p.OwnedCars) == 0 {
+			giveStarterCar(p)
+			g.gameLogic.SaveCurrentProfile()
+		}
+
+		// Resume straight onto the road if the profile already has a car,
+		// from the mid-level save if there is one
+		if currentCar := p.CurrentCar(); currentCar != nil {
+			g.startGameplay(currentCar, g.gameLogic.LoadSnapshot())
+			return
+		}
+		g.showGarage()
+	}, func() {
+		g.currentScreen = ui.NewLoadingScreen(g.showNewGame, g.showLoadGame)
+	})
+}
+
+// showGarage lets the current profile pick one of its cars and starts the game with it
+func (g *Game) showGarage() {
+	p := g.gameLogic.CurrentProfile()
+	g.currentScreen = ui.NewGarageScreen(p.OwnedCars, p.CurrentCarIndex, p.Money, p.Licence, func(index int) {
+		if index != p.CurrentCarIndex {
+			// A mid-level save would put the previous car back on the road
+			g.gameLogic.DeleteSnapshot()
+		}
+		p.CurrentCarIndex = index
+		g.gameLogic.SaveCurrentProfile()
+
+		// Start the actual game with selected car
+		g.startGameplay(p.CurrentCar(), nil)
+	}, g.showDealership, g.showTuningShop, g.showLevelSelect, func() error {
+		if err := g.gameLogic.TakeCourse(); err != nil {
+			return err
+		}
+		g.showGarage()
+		return nil
+	})
+}
+
+// showLevelSelect lets the current profile choose which unlocked level to drive next, and in which mode, then starts it
+func (g *Game) showLevelSelect() {
+	p := g.gameLogic.CurrentProfile()
 	levelData := g.gameLogic.LevelData()
-	if len(levelData) > 0 {
-		g.currentScreen = NewGameplayScreen(selectedCar, levelData[0], func() {
-			// When game ends, go back to title
-			g.currentScreen = ui.NewTitleScreen(func() {
-				g.currentScreen = ui.NewLoadingScreen(func(gameState *models.GameState) {
-					g.currentScreen = ui.NewGarageScreen(func(car *car.Car) {
-						g.startGameplay(car)
-					})
-				})
-			})
-		})
-	} else {
+	modes := modeChoices(p.Licence.Suspended)
+	choices := make([]ui.LevelChoice, len(levelData))
+	for i, level := range levelData {
+		choices[i] = ui.LevelChoice{
+			Name:          level.Name,
+			RequiredLevel: level.RequiredLevel,
+			Unlocked:      g.gameLogic.levelUnlocked(i),
+			Leaderboards:  make(map[string][]leaderboard.Entry, len(modes)),
+		}
+		for _, mode := range modes {
+			choices[i].Leaderboards[mode.ID] = g.gameLogic.Leaderboard(level.Name, mode.ID)
+		}
+	}
+
+	g.currentScreen = ui.NewLevelSelectScreen(choices, modes, p.Level, p.CurrentLevel, func(index int, mode string) {
+		if index != p.CurrentLevel {
+			// A mid-level save would put the player back on the previous level
+			g.gameLogic.DeleteSnapshot()
+		}
+		p.CurrentLevel = index
+		g.gameLogic.SaveCurrentProfile()
+		g.startLevel(p.CurrentCar(), index, nil, mode)
+	}, g.showGarage)
+}
+
+// showTuningShop sells upgrades for the current profile's car at index, then returns to the garage
+func (g *Game) showTuningShop(index int) {
+	p := g.gameLogic.CurrentProfile()
+	if index < 0 || index >= len(p.OwnedCars) {
+		return
+	}
+	c := p.OwnedCars[index]
+	g.currentScreen = ui.NewTuningShopScreen(p, c, func(part car.Part) error {
+		return g.gameLogic.BuyPart(c, part)
+	}, g.showGarage)
+}
+
+// showDealership lets the current profile buy and sell cars, then returns to the garage
+func (g *Game) showDealership() {
+	g.currentScreen = ui.NewDealershipScreen(g.gameLogic.CurrentProfile(), g.gameLogic.BuyCar, g.gameLogic.SellCar, g.showGarage)
+}
+
+// startGameplay transitions to the actual gameplay, resuming from snap if it is not nil
+func (g *Game) startGameplay(selectedCar *car.Car, snap *Snapshot) {
+	levelData := g.gameLogic.LevelData()
+	if len(levelData) == 0 {
 		// Fallback to title if no levels loaded
-		g.currentScreen = ui.NewTitleScreen(func() {
-			g.currentScreen = ui.NewLoadingScreen(func(gameState *models.GameState) {
-				g.currentScreen = ui.NewGarageScreen(func(car *car.Car) {
-					g.startGameplay(car)
-				})
-			})
-		})
+		g.showTitle()
+		return
+	}
+
+	// Resume the level the profile was last playing
+	p := g.gameLogic.CurrentProfile()
+	levelIndex := 0
+	if p != nil && p.CurrentLevel >= 0 && p.CurrentLevel < len(levelData) && g.gameLogic.levelUnlocked(p.CurrentLevel) {
+		levelIndex = p.CurrentLevel
+	}
+	if snap != nil {
+		levelIndex = g.gameLogic.snapshotLevelIndex(snap)
+	}
+
+	g.startLevel(selectedCar, levelIndex, snap, ModeStandard)
+}
+
+// startLevel starts gameplay on a level in one of the Mode* modes, resuming from snap if it is not nil
+func (g *Game) startLevel(selectedCar *car.Car, levelIndex int, snap *Snapshot, mode string) {
+	p := g.gameLogic.CurrentProfile()
+	if p != nil && p.Licence.Suspended && requiresLicence(mode) {
+		// A retry after the run that got the licence suspended
+		g.showLevelSelect()
+		return
+	}
+
+	// Taxi shifts are driven in the company's cab, never the player's own car
+	driven := selectedCar
+	if mode == ModeTaxi && p != nil {
+		driven = taxiCab(p.TaxiRating, p.TaxiTrips)
+	}
+	if mode == ModeHGV {
+		driven = hgvTruck(g.gameLogic.LevelData()[levelIndex].Name)
+	}
+
+	var gameplay *GameplayScreen
+	gameplay = NewGameplayScreen(driven, g.gameLogic.LevelData()[levelIndex], func(results ui.RunResults) {
+		// When game ends, write the run back into the profile, save and show the results
+		if p != nil {
+			gameplay.RecordToProfile(p)
+		}
+		if results.Outcome != ui.RunQuit && results.Mode == ModeStandard {
+			// A save from part way through a level that has now ended would replay it
+			g.gameLogic.DeleteSnapshot()
+		}
+		g.gameLogic.SaveCurrentProfile()
+		if p != nil {
+			results.Rank = g.gameLogic.SubmitScore(p, driven, results, gameplay.finishedGhost())
+		}
+		results.Leaderboard = g.gameLogic.Leaderboard(results.LevelName, results.Mode)
+		g.showResults(results, selectedCar, levelIndex)
+	})
+	gameplay.levelIndex = levelIndex
+	gameplay.onSave = g.gameLogic.SaveSnapshot
+	if p != nil {
+		gameplay.RestoreFromProfile(p)
+	}
+	if snap != nil {
+		if p == nil {
+			gameplay.restoreSnapshotProgress(snap)
+		}
+		if err := gameplay.RestoreSnapshot(snap); err != nil {
+			log.Printf("Failed to restore mid-level save: %v", err)
+		}
+	}
+	if mode == ModeTimeTrial {
+		// Time trials are driven in one go, against the quickest recorded run
+		gameplay.onSave = nil
+		gameplay.startTimeTrial(g.gameLogic.LoadGhost(gameplay.levelData.Name))
+	}
+	if mode == ModeTaxi && p != nil {
+		// A shift runs until the driver is too tired to go on, so it cannot be saved part way
+		gameplay.onSave = nil
+		gameplay.startTaxiShift(p.TaxiRating, p.TaxiTrips)
+	}
+	if mode == ModeHGV {
+		// The tachograph is not part of a save, so HGV runs are driven in one go
+		gameplay.onSave = nil
+		gameplay.startHGV()
 	}
+	g.currentScreen = gameplay
+}
+
+// showResults shows how a run went and offers to retry, go on to the next level or visit the garage
+func (g *Game) showResults(results ui.RunResults, selectedCar *car.Car, levelIndex int) {
+	onRetry := func() {
+		g.startLevel(selectedCar, levelIndex, nil, results.Mode)
+	}
+
+	var onNextLevel func()
+	if results.Outcome == ui.RunCompleted {
+		next := g.gameLogic.nextLevelIndex(levelIndex, results.ExitTaken)
+		if next >= 0 && !g.gameLogic.levelUnlocked(next) {
+			results.NextLevelNote = fmt.Sprintf("LEVEL %s UNLOCKS AT PLAYER LEVEL %d", g.gameLogic.levelData[next].Name, g.gameLogic.levelData[next].RequiredLevel)
+		} else if next >= 0 {
+			onNextLevel = func() {
+				if p := g.gameLogic.CurrentProfile(); p != nil {
+					p.CurrentLevel = next
+					g.gameLogic.SaveCurrentProfile()
+				}
+				g.startLevel(selectedCar, next, nil, results.Mode)
+			}
+		}
+	}
+
+	// Replayed snapshots have no profile to take to the garage
+	onGarage := g.showTitle
+	if g.gameLogic.CurrentProfile() != nil {
+		onGarage = g.showGarage
+	}
+
+	g.currentScreen = ui.NewResultsScreen(results, onRetry, onNextLevel, onGarage)
+}
+
+// ReplaySnapshot starts gameplay straight from a snapshot file, such as a crash-repro dump.
+// No profile is loaded, so the run is not recorded anywhere.
+func (g *Game) ReplaySnapshot(path string) error {
+	snap, err := readSnapshot(path)
+	if err != nil {
+		return err
+	}
+	if snap.Player.Car == nil {
+		return fmt.Errorf("%s: snapshot has no player car", path)
+	}
+	g.startGameplay(snap.Player.Car.Clone(), snap)
+	return nil
+}
+
+// dumpCrashSnapshot is deferred by Update. If gameplay panics, it writes a snapshot of the
+// running level next to the profiles so the crash can be replayed with -snapshot, then re-panics.
+func (g *Game) dumpCrashSnapshot() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if gameplay, ok := g.currentScreen.(*GameplayScreen); ok && g.gameLogic.profileStore != nil {
+		func() {
+			// The state may be too broken to snapshot; never hide the original panic
+			defer func() {
+				if err := recover(); err != nil {
+					log.Printf("Failed to write crash snapshot: %v", err)
+				}
+			}()
+
+			dir, err := g.gameLogic.profileStore.CrashDir()
+			if err != nil {
+				log.Printf("Failed to write crash snapshot: %v", err)
+				return
+			}
+			path := filepath.Join(dir, "crash-"+time.Now().Format("20060102-150405")+".json")
+			if err := writeSnapshot(path, gameplay.TakeSnapshot(SnapshotCrash)); err != nil {
+				log.Printf("Failed to write crash snapshot: %v", err)
+				return
+			}
+			log.Printf("Wrote crash snapshot %s", path)
+		}()
+	}
+
+	panic(r)
 }
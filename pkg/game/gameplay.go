@@ -3,14 +3,26 @@ package game
 import (
 	"fmt"
 	"image/color"
+	"log"
 	"math"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/contracts"
 	"github.com/golangdaddy/roadster/pkg/data"
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/licence"
 	"github.com/golangdaddy/roadster/pkg/models"
 	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/golangdaddy/roadster/pkg/scoring"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/golangdaddy/roadster/pkg/wanted"
 	"github.com/hajimehoshi/bitmapfont/v4"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -48,6 +60,15 @@ type TrafficCar struct {
 	Color              color.RGBA // Car color for variety
 	LastLaneChangeTime int64      // Timestamp of last lane change
 	Passed             bool       // Whether the player has passed this car
+	NearMiss           bool       // The player came within nearMissMargin of it without touching
+	Touched            bool       // The player has crashed into it
+
+	// Police patrol cars (see police.go)
+	Police          bool    // A patrol car, which clocks the player's speed
+	Pursuing        bool    // Chasing the player, driven by pursue instead of the traffic AI
+	ClockedMPH      float64 // The player's speed when the chase began, and the limit they broke
+	ClockedLimitMPH float64
+	Roadblock       bool // Part of a rolling roadblock, driven by holdRoadblock (see wanted.go)
 
 	// First-Class Object Fields
 	ID         string
@@ -95,7 +116,7 @@ func (tc *TrafficCar) PhysicsUpdate(gs *GameplayScreen) {
 		tc.X = targetX
 		tc.Lane = tc.TargetLane
 		tc.TargetLane = 0
-		tc.LastLaneChangeTime = time.Now().UnixMilli()
+		tc.LastLaneChangeTime = gs.nowMillis()
 		tc.VelocityX *= 0.5 // Dampen residual velocity
 		tc.SteeringAngle = 0
 
@@ -189,6 +210,16 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 		return
 	}
 
+	// Patrol cars chasing the player are driven by pursue, and roadblocks by holdRoadblock
+	if tc.Roadblock {
+		tc.holdRoadblock(gs)
+		return
+	}
+	if tc.Pursuing {
+		tc.pursue(gs)
+		return
+	}
+
 	// Check for pedestrian
 	if gs.onFoot && gs.playerPed != nil {
 		dist := math.Hypot(tc.X-gs.playerPed.X, tc.Y-gs.playerPed.Y)
@@ -250,7 +281,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 		if other.Lane == tc.Lane {
 			// Check if other car is ahead (Y is smaller)
 			if other.Y < tc.Y {
-				dist := tc.Y - other.Y
+				dist := tc.gapTo(other)
 				if dist < minDist {
 					minDist = dist
 					foundCarAhead = true
@@ -259,7 +290,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 			}
 			// Check if other car is behind (Y is larger)
 			if other.Y > tc.Y {
-				dist := other.Y - tc.Y
+				dist := tc.gapTo(other)
 				if dist < minDistBehind {
 					minDistBehind = dist
 					foundCarBehind = true
@@ -297,6 +328,11 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 		}
 	}
 
+	// HGVs are kept out of the faster lanes
+	if tc.isHGV() && !hgvLaneAllowed(tcSegment, tc.Lane+1) {
+		rightLaneBlocked = true
+	}
+
 	// Check against player
 	laneWidth := 80.0
 
@@ -350,6 +386,9 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 	}
 	speedLimitMPH := 50.0 + float64(lanePosition)*10.0
 	baseTargetSpeed := speedLimitMPH / MPHPerPixelPerFrame
+	if tc.isHGV() {
+		baseTargetSpeed = min(baseTargetSpeed, hgvSpeedLimiterMPH/MPHPerPixelPerFrame)
+	}
 
 	// Default to base target speed
 	tc.TargetSpeed = baseTargetSpeed
@@ -527,7 +566,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 				}
 
 				// Decide which way to merge based on where the road went
-				currentTime := time.Now().UnixMilli()
+				currentTime := gs.nowMillis()
 
 				// If we are to the LEFT of the new start (road moved right) -> Merge Right
 				if currentAbsLane < nextStartLaneIdx {
@@ -554,7 +593,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 	// Attempt lane change
 	if tc.LaneProgress == 0 && tc.TargetLane == 0 {
 		// Cooldown check (10 seconds)
-		now := time.Now().UnixMilli()
+		now := gs.nowMillis()
 		if now-tc.LastLaneChangeTime < 10000 {
 			return
 		}
@@ -615,7 +654,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 			// CRITICAL: Ensure we don't move into Lane 0
 			if canLeft && (tc.Lane-1) >= 1 {
 				// 5% chance per frame to actually initiate the move (makes it feel natural but persistent)
-				if rand.Float64() < 0.05 {
+				if gs.rng.Float64() < 0.05 {
 					tc.TargetLane = tc.Lane - 1
 					tc.LaneProgress = 0.01
 					return
@@ -630,7 +669,7 @@ func (tc *TrafficCar) Update(gs *GameplayScreen) {
 				canRight := !rightLaneBlocked
 				if canRight {
 					// 2% chance to overtake (reluctant to move to fast lane)
-					if rand.Float64() < 0.02 {
+					if gs.rng.Float64() < 0.02 {
 						tc.TargetLane = tc.Lane + 1
 						tc.LaneProgress = 0.01
 						return
@@ -651,13 +690,19 @@ type Car struct {
 	Acceleration     float64 // Acceleration rate
 	TurnSpeed        float64 // How fast the car turns
 	SteeringResponse float64 // How quickly steering returns to center
+	BrakeForce       float64 // Deceleration under braking
+	Grip             float64 // How quickly VelocityX follows the steering
+	TopSpeedFactor   float64 // Fraction of the speed limit the car can reach
+	Pull             float64 // Sideways pull from bad alignment, -1 (left) to 1 (right)
 	SelectedCar      *car.Car
 	Sprite           *ebiten.Image
 }
 
 type PetrolStation struct {
-	X, Y float64
-	Lane int
+	X, Y        float64
+	Lane        int
+	ServiceType int     // road.ServiceType* sold here
+	FuelPrice   float64 // Price per litre, for petrol stations
 }
 
 type Billboard struct {
@@ -678,20 +723,30 @@ type GameplayScreen struct {
 	roadTextures            map[string]*ebiten.Image
 	screenWidth             int
 	screenHeight            int
-	cameraX                 float64       // Camera X offset to follow car
-	cameraY                 float64       // Camera Y offset to follow target
-	onGameEnd               func()        // Callback when game ends
-	levelData               *LevelData    // Store level data for reset
-	initialX                float64       // Initial player X position
-	initialY                float64       // Initial player Y position
-	backgroundPattern       *ebiten.Image // Repeating background pattern
-	lastSpawnTime           int64         // Timestamp of last spawn attempt
-	spawnCooldown           int64         // Minimum time between spawn attempts (in milliseconds)
-	DistanceTravelled       float64       // Total miles travelled
-	TotalCarsPassed         int           // Total number of cars passed
-	Level                   int           // Current player level
-	LevelThreshold          int           // Total cars needed to reach next level
-	PrevLevelThreshold      int           // Total cars needed to reach current level (for progress bar)
+	cameraX                 float64             // Camera X offset to follow car
+	cameraY                 float64             // Camera Y offset to follow target
+	onGameEnd               func(ui.RunResults) // Callback when game ends
+	ended                   bool                // Set once the run has been reported, so it is only ended once
+	levelData               *LevelData          // Store level data for reset
+	levelIndex              int                 // Index of levelData in GameLogic (for hot-reload)
+	initialX                float64             // Initial player X position
+	initialY                float64             // Initial player Y position
+	backgroundPattern       *ebiten.Image       // Repeating background pattern
+	lastSpawnTime           int64               // Timestamp of last spawn attempt
+	spawnCooldown           int64               // Minimum time between spawn attempts (in milliseconds)
+	DistanceTravelled       float64             // Total miles travelled
+	TotalCarsPassed         int                 // Total number of cars passed
+	sessionStartCarsPassed  int                 // TotalCarsPassed when the session started (restored from the profile)
+	sessionStartDistance    float64             // DistanceTravelled when the session started (non-zero after a snapshot restore)
+	sessionStartCrashes     int                 // Crashes when the session started (non-zero after a snapshot restore)
+	sessionStartLevel       int                 // Level when the session started
+	sessionStartTicks       int64               // ticks when the session started (non-zero after a snapshot restore)
+	fuelUsed                float64             // Litres burnt this session
+	exitTaken               string              // Destination of the exit the player left the level by
+	Level                   int                 // Current player level, following from XP
+	XP                      int                 // Lifetime XP (see xp.go)
+	sessionStartXP          int                 // XP when the session started
+	cleanMiles              float64             // Miles driven since the last crash, towards the next clean-mile XP
 	paused                  bool
 	onFoot                  bool
 	playerPed               *PlayerPed
@@ -706,35 +761,120 @@ type GameplayScreen struct {
 	FoodLevel               float64 // Player food level (0-100 scale)
 	ToiletLevel             float64 // How full the player's bladder is (0-100 scale)
 	showDebug               bool    // Toggle for debug info overlay
+	toasts                  []toast // Short messages shown at the bottom of the screen
+
+	// Crash respawns (see checkpoint.go)
+	checkpoint        Checkpoint // Where the player respawns after a crash
+	difficulty        string     // profile.Difficulty* setting
+	respawnGraceUntil int64      // Crashes are not counted until this tick
+
+	// Game clock, traffic RNG and mid-level saves (see snapshot.go)
+	onSave  func(*Snapshot) error // Callback that persists a mid-level save
+	rng     *rand.Rand            // Source of randomness for traffic, reseeded on every snapshot
+	rngSeed int64                 // Seed rng was last seeded with
+	ticks   int64                 // Game ticks simulated so far; the clock for all gameplay timers
+
+	// Money (see services.go)
+	wallet            *economy.Wallet // Player's money; Earned/Spent cover this session
+	sessionStartMoney float64         // Balance when the session began, to record the change to the profile
+	brokeToastUntil   int64           // Tick until which "not enough money" is not repeated
+
+	// Petrol station pump panel (see services.go)
+	petrolScreen *ui.PetrolStationScreen // Open while the player is buying fuel; the road is frozen meanwhile
+	atStation    int                     // Index of the station the player is stopped at, or -1
+	stopRewarded bool                    // Service stop XP has been given for the current stop
+	pumpShown    bool                    // The pump panel has been shown for the current stop
+
+	// Consequences of running out of fuel, food, sleep or bladder (see needs.go)
+	needWarnings    int       // warn* flags for warnings already shown
+	brokenDown      bool      // Out of fuel; waiting for a jerry can or recovery truck
+	jerryCanReadyAt int64     // Tick a fetched jerry can arrives, or 0
+	pullingOver     bool      // Bladder full; stopping to go by the roadside
+	microsleepUntil int64     // The player's eyes are shut until this tick
+	microsleepSteer float64   // Steering the car wanders with during a microsleep
+	steeringHistory []float64 // Recent steering inputs, replayed late when hungry
+
+	// Score (see score.go)
+	mode      string // One of the Mode* constants; decides the leaderboard table
+	score     *scoring.Engine
+	bestScore int          // The profile's best score on this level before this run
+	popups    []scorePopup // Points floating up from the player's car
+
+	// Time trial (see timetrial.go)
+	trial *timeTrial // Clock, splits and ghost; nil unless the run is a time trial
+
+	// Delivery contracts (see contracts.go)
+	activeContracts     []contracts.Contract
+	contractBoard       *ui.ContractBoardScreen // Open while the player is reading a shop's board; the road is frozen meanwhile
+	deliveriesCompleted int                     // Lifetime deliveries, which move the shop boards on
+
+	// Hitchhikers and passengers (see passengers.go)
+	hitchhikers      []Hitchhiker // Waiting at the laybys
+	passengers       []Hitchhiker // Riding with the player
+	hitchhikerSprite *ebiten.Image
+
+	// Taxi shift (see taxi.go)
+	taxi *taxiShift // Customers and the meter; nil unless the run is a taxi shift
+
+	// HGVs and the tachograph (see hgv.go)
+	hgv         *haulage // Tachograph and fines; nil unless the run is an HGV run
+	truckSprite *ebiten.Image
+
+	// Police, speed cameras and the licence (see police.go)
+	licence          licence.Licence
+	pursuer          *TrafficCar // The patrol car chasing the player, or nil
+	policeStop       bool        // The pursuer has told the player to pull over
+	cameraSegment    int         // Last speed camera segment that read the player's speed
+	cameraFlashUntil int64
+	offences         int // This run's tickets, the points they added and the fines paid
+	penaltyPoints    int
+	offenceFines     float64
+
+	// Wanted level and police chases (see wanted.go)
+	wanted       wanted.Level
+	roadblocks   map[int]bool // Layby segments a roadblock has been set up at
+	nextChaserAt int64        // Tick from which another patrol car may join the chase
+	carsStolen   int
+	escapes      int
+	arrests      int
 }
 
 // NewGameplayScreen creates a new gameplay screen
-func NewGameplayScreen(selectedCar *car.Car, levelData *LevelData, onGameEnd func()) *GameplayScreen {
+func NewGameplayScreen(selectedCar *car.Car, levelData *LevelData, onGameEnd func(ui.RunResults)) *GameplayScreen {
+	// Traffic is driven by its own seeded generator so a snapshot can reproduce it
+	seed := time.Now().UnixNano()
+
 	gs := &GameplayScreen{
-		roadSegments:       make([]RoadSegment, 0),
-		petrolStations:     make([]PetrolStation, 0),
-		billboards:         make([]Billboard, 0),
-		traffic:            make([]*TrafficCar, 0),
-		scrollSpeed:        2.0,
-		roadTextures:       make(map[string]*ebiten.Image),
-		screenWidth:        1024,
-		screenHeight:       600,
-		onGameEnd:          onGameEnd,
-		lastSpawnTime:      time.Now().UnixMilli(),
-		spawnCooldown:      215 + rand.Int63n(143), // 215-358ms random cooldown (30% reduction in spawn frequency)
-		DistanceTravelled:  0,
-		TotalCarsPassed:    0,
-		Level:              1,
-		LevelThreshold:     172, // Start with 172 cars to level up (matches config)
-		PrevLevelThreshold: 0,
-		Crashes:            0,
-		lastCrashTime:      0,
-		SleepCapacity:      100.0,
-		SleepLevel:         100.0, // Start well-rested
-		FoodCapacity:       100.0,
-		FoodLevel:          100.0, // Start full
-		ToiletLevel:        0.0,   // Start with empty bladder
-	}
+		roadSegments:      make([]RoadSegment, 0),
+		petrolStations:    make([]PetrolStation, 0),
+		billboards:        make([]Billboard, 0),
+		traffic:           make([]*TrafficCar, 0),
+		scrollSpeed:       2.0,
+		roadTextures:      make(map[string]*ebiten.Image),
+		screenWidth:       1024,
+		screenHeight:      600,
+		onGameEnd:         onGameEnd,
+		rng:               rand.New(rand.NewSource(seed)),
+		rngSeed:           seed,
+		wallet:            economy.NewWallet(0),
+		score:             scoring.NewEngine(),
+		mode:              ModeStandard,
+		atStation:         -1,
+		cameraSegment:     -1,
+		DistanceTravelled: 0,
+		TotalCarsPassed:   0,
+		Level:             1,
+		sessionStartLevel: 1,
+		Crashes:           0,
+		lastCrashTime:     0,
+		SleepCapacity:     100.0,
+		SleepLevel:        100.0, // Start well-rested
+		FoodCapacity:      100.0,
+		FoodLevel:         100.0, // Start full
+		ToiletLevel:       0.0,   // Start with empty bladder
+	}
+
+	gs.spawnCooldown = 215 + gs.rng.Int63n(143) // 215-358ms random cooldown (30% reduction in spawn frequency)
 
 	// Initialize player car
 	laneWidth := 80.0
@@ -751,17 +891,20 @@ func NewGameplayScreen(selectedCar *car.Car, levelData *LevelData, onGameEnd fun
 		VelocityX:        0,
 		VelocityY:        0,
 		SteeringAngle:    0,
-		Acceleration:     0.05, // Reduced for more gradual speed transitions
 		TurnSpeed:        6.0,  // Higher target speed to compensate for inertia
 		SteeringResponse: 0.05, // Smoother steering return
 		SelectedCar:      selectedCar,
 	}
+	gs.applyCarStats() // Acceleration, braking and grip come from the car (see handling.go)
 
 	// Store initial position and level data for reset
 	gs.initialX = initialX
 	gs.initialY = initialY
 	gs.levelData = levelData
 
+	gs.difficulty = profile.DifficultyNormal
+	gs.checkpoint = gs.levelStartCheckpoint()
+
 	// Load road textures
 	gs.loadRoadTextures()
 
@@ -777,29 +920,20 @@ func NewGameplayScreen(selectedCar *car.Car, levelData *LevelData, onGameEnd fun
 	return gs
 }
 
+// nowMillis returns the game clock in milliseconds (ticks at 60 FPS)
+func (gs *GameplayScreen) nowMillis() int64 {
+	return gs.ticks * 1000 / 60
+}
+
 // loadRoadTextures loads the road texture assets
 func (gs *GameplayScreen) loadRoadTextures() {
-	// Load road textures with correct letter mapping
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/A.png"); err == nil {
-		gs.roadTextures["A"] = img
-	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/B.png"); err == nil {
-		gs.roadTextures["B"] = img
-	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/C.png"); err == nil {
-		gs.roadTextures["C"] = img
-	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/D.png"); err == nil {
-		gs.roadTextures["D"] = img
-	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/E.png"); err == nil {
-		gs.roadTextures["E"] = img
+	// Every road/<letter>.png tile is keyed by its letter, so new road types only need a texture
+	textures, err := assets.Default.RoadTextures()
+	if err != nil {
+		log.Printf("Failed to load road textures: %v", err)
 	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/F.png"); err == nil {
-		gs.roadTextures["F"] = img
-	}
-	if img, _, err := ebitenutil.NewImageFromFile("assets/road/G.png"); err == nil {
-		gs.roadTextures["G"] = img
+	for letter, img := range textures {
+		gs.roadTextures[letter] = img
 	}
 }
 
@@ -809,6 +943,12 @@ func (gs *GameplayScreen) generateRoadFromLevel(levelData *LevelData) {
 
 	y := float64(gs.screenHeight) // Start from bottom of screen
 
+	// What each layby segment sells (petrol unless the level says otherwise)
+	services := make(map[int]int, len(levelData.Services))
+	for _, service := range levelData.Services {
+		services[service.Segment] = service.Type
+	}
+
 	// First pass: Generate segments and find petrol stations
 	for i, segment := range levelData.Segments {
 		// Start with only 1 lane for the first few segments
@@ -851,9 +991,13 @@ func (gs *GameplayScreen) generateRoadFromLevel(levelData *LevelData) {
 				laneX := leftEdge + float64(laneIdx)*laneWidth + laneWidth/2
 
 				station := PetrolStation{
-					X:    laneX - 100,
-					Y:    y - segmentHeight/2,
-					Lane: laneIdx,
+					X:           laneX - 100,
+					Y:           y - segmentHeight/2,
+					Lane:        laneIdx,
+					ServiceType: services[i],
+				}
+				if station.ServiceType == road.ServiceTypePetrol {
+					station.FuelPrice = economy.FuelPrice(fmt.Sprintf("%s/%d", levelData.Name, i))
 				}
 				gs.petrolStations = append(gs.petrolStations, station)
 			}
@@ -913,6 +1057,9 @@ func (gs *GameplayScreen) generateRoadFromLevel(levelData *LevelData) {
 			})
 		}
 	}
+
+	// Hitchhikers wait at the laybys
+	gs.spawnHitchhikers()
 }
 
 // isLaneClear checks if a lane is safe to enter
@@ -982,6 +1129,10 @@ func (gs *GameplayScreen) updateAutoPilot(currentSegment RoadSegment, segmentIdx
 
 		boundRight = boundRight + (nextRight-boundRight)*progress
 		boundLeft = boundLeft + (nextLeft-boundLeft)*progress
+		if gs.playerCar.SelectedCar.IsHGV() {
+			// Lanes an HGV may not use count as unavailable
+			boundRight = min(boundRight, gs.hgvRightEdge(segmentIdx, laneWidth))
+		}
 
 		// PREDICTIVE LANE CHECK:
 		// If the current lane will not exist in the next segment (lane count decreasing),
@@ -1007,12 +1158,12 @@ func (gs *GameplayScreen) updateAutoPilot(currentSegment RoadSegment, segmentIdx
 				// Lane ends on the left (road shifts right) - Force move Right
 				gs.autoDriveLane++
 				laneChanged = true
-				gs.lastAutoDriveLaneChange = time.Now().UnixMilli() // Reset cooldown to allow chain moves
+				gs.lastAutoDriveLaneChange = gs.nowMillis() // Reset cooldown to allow chain moves
 			} else if currentAbsLane >= nextStartLaneIdx+nextSegment.LaneCount {
 				// Lane ends on the right (road shifts left) - Force move Left
 				gs.autoDriveLane--
 				laneChanged = true
-				gs.lastAutoDriveLaneChange = time.Now().UnixMilli()
+				gs.lastAutoDriveLaneChange = gs.nowMillis()
 			} else {
 				// NEW: Check if next lane exists but is closing ("E" or "C")
 				// We want to vacate BEFORE we enter the closing segment if possible.
@@ -1028,14 +1179,14 @@ func (gs *GameplayScreen) updateAutoPilot(currentSegment RoadSegment, segmentIdx
 							if gs.autoDriveLane > 0 {
 								gs.autoDriveLane--
 								laneChanged = true
-								gs.lastAutoDriveLaneChange = time.Now().UnixMilli()
+								gs.lastAutoDriveLaneChange = gs.nowMillis()
 							}
 						} else if nextType == "C" {
 							// C is merge right (lane ending on left) -> Move Right
 							if gs.autoDriveLane < currentSegment.LaneCount-1 {
 								gs.autoDriveLane++
 								laneChanged = true
-								gs.lastAutoDriveLaneChange = time.Now().UnixMilli()
+								gs.lastAutoDriveLaneChange = gs.nowMillis()
 							}
 						}
 					}
@@ -1123,7 +1274,7 @@ func (gs *GameplayScreen) updateAutoPilot(currentSegment RoadSegment, segmentIdx
 	// Only change if we aren't already changing (aligned with lane)
 	if math.Abs(gs.playerCar.X-targetLaneX) < 20 {
 		// Check minimum lane hold time (2 seconds)
-		now := time.Now().UnixMilli()
+		now := gs.nowMillis()
 		canChangeLanes := (now - gs.lastAutoDriveLaneChange) >= 2000
 
 		// Primary goal: Stay in rightmost lane (fast lane) at all costs
@@ -1206,12 +1357,28 @@ func (gs *GameplayScreen) updateAutoPilot(currentSegment RoadSegment, segmentIdx
 
 // Update handles gameplay logic
 func (gs *GameplayScreen) Update() error {
+	// The pump panel has the keyboard, and the road waits until the player drives on
+	if gs.petrolScreen != nil {
+		return gs.petrolScreen.Update()
+	}
+	if gs.contractBoard != nil {
+		return gs.contractBoard.Update()
+	}
+
+	inPauseMenu := gs.paused && !gs.showDebug
+
 	// Toggle Debug/Profile View
-	if inpututil.IsKeyJustPressed(ebiten.KeyX) {
+	if inpututil.IsKeyJustPressed(ebiten.KeyX) && !inPauseMenu {
 		gs.paused = !gs.paused
 		gs.showDebug = !gs.showDebug // Assuming we reuse a debug flag or create a new one
 	}
 
+	// Toggle Pause Menu
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) && !gs.showDebug {
+		gs.paused = !gs.paused
+		return nil
+	}
+
 	if gs.paused {
 		if gs.showDebug {
 			// Just return nil to keep drawing the frozen frame with debug overlay
@@ -1221,6 +1388,13 @@ func (gs *GameplayScreen) Update() error {
 		return gs.updatePauseMenu()
 	}
 
+	// Game time only advances while the simulation runs, so pauses and snapshots do not shift timers
+	gs.ticks++
+
+	// Time trials run their clock and record the ghost
+	gs.updateTimeTrial()
+	gs.updateContracts()
+
 	currentSegment, segmentIdx := gs.getCurrentRoadSegment()
 	laneWidth := 80.0
 
@@ -1230,14 +1404,24 @@ func (gs *GameplayScreen) Update() error {
 		// If player has reached the top of the last segment (finished the level)
 		if gs.playerCar.Y <= lastSegment.Y {
 			// Level completed! Clean up and call the end game callback
-			gs.cleanupTraffic()
-			if gs.onGameEnd != nil {
-				gs.onGameEnd()
-			}
+			gs.finishTimeTrial()
+			gs.endRun(ui.RunCompleted)
 			return nil
 		}
 	}
 
+	// Laybys are checkpoints
+	gs.updateCheckpoint(segmentIdx)
+	gs.checkSpeedCamera(segmentIdx)
+
+	// Leaving by an exit slip road also completes the level
+	if gs.checkExitTaken(currentSegment, segmentIdx) {
+		gs.deliverContracts(gs.exitTaken)
+		gs.dropOffPassengers(gs.exitTaken)
+		gs.endRun(ui.RunCompleted)
+		return nil
+	}
+
 	// Handle inputs
 	if gs.onFoot {
 		gs.updatePed()
@@ -1256,10 +1440,17 @@ func (gs *GameplayScreen) Update() error {
 			}
 		}
 
+		// Save while parked in a layby
+		if inpututil.IsKeyJustPressed(ebiten.KeyS) && gs.canSaveAtLayby() {
+			gs.saveGame(SnapshotLayby)
+		}
+
 		// Calculate current lane and speed limit
 		currentLane := gs.getCurrentLane(currentSegment, laneWidth)
 		speedLimitMPH := 50.0 + float64(currentLane)*10.0
-		maxSpeed := speedLimitMPH / MPHPerPixelPerFrame
+		maxSpeed := gs.hgvMaxSpeed(speedLimitMPH / MPHPerPixelPerFrame * gs.playerCar.TopSpeedFactor)
+		gs.score.UpdateSpeed(gs.playerCar.VelocityY*MPHPerPixelPerFrame, speedLimitMPH)
+		gs.updatePassengerMood(gs.playerCar.VelocityY*MPHPerPixelPerFrame, speedLimitMPH)
 
 		// Toggle Auto Drive
 		if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
@@ -1268,27 +1459,20 @@ func (gs *GameplayScreen) Update() error {
 				// DOMINATE THE RIGHT LANE: Always start in the rightmost (fastest) lane
 				gs.autoDriveLane = currentSegment.LaneCount - 1
 				// Initialize lane change timer
-				gs.lastAutoDriveLaneChange = time.Now().UnixMilli()
+				gs.lastAutoDriveLaneChange = gs.nowMillis()
 			}
 		}
 
 		if gs.autoDrive {
 			gs.updateAutoPilot(currentSegment, segmentIdx, laneWidth, maxSpeed)
 		} else {
-			// Handle steering input (Left/Right arrow keys)
+			// Handle steering input (Left/Right arrow keys, delayed by hunger and lost to microsleeps)
 			maxSteeringAngle := 1.0
 			steeringInput := 0.08
 
-			if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
-				gs.playerCar.SteeringAngle -= steeringInput
-				if gs.playerCar.SteeringAngle < -maxSteeringAngle {
-					gs.playerCar.SteeringAngle = -maxSteeringAngle
-				}
-			} else if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
-				gs.playerCar.SteeringAngle += steeringInput
-				if gs.playerCar.SteeringAngle > maxSteeringAngle {
-					gs.playerCar.SteeringAngle = maxSteeringAngle
-				}
+			if steer := gs.steeringInput(); steer != 0 {
+				gs.playerCar.SteeringAngle += steeringInput * steer
+				gs.playerCar.SteeringAngle = max(-maxSteeringAngle, min(maxSteeringAngle, gs.playerCar.SteeringAngle))
 			} else {
 				// Return steering to center when no input
 				if gs.playerCar.SteeringAngle > 0 {
@@ -1305,7 +1489,9 @@ func (gs *GameplayScreen) Update() error {
 			}
 
 			minSpeed := 0.0
-			if ebiten.IsKeyPressed(ebiten.KeyArrowUp) && gs.playerCar.SelectedCar.FuelLevel > 0 {
+			if gs.forcedStop() {
+				gs.applyForcedStop()
+			} else if ebiten.IsKeyPressed(ebiten.KeyArrowUp) && gs.playerCar.SelectedCar.FuelLevel > 0 {
 				if math.Abs(gs.playerCar.VelocityY-maxSpeed) < gs.playerCar.Acceleration {
 					gs.playerCar.VelocityY = maxSpeed
 				} else if gs.playerCar.VelocityY < maxSpeed {
@@ -1315,7 +1501,11 @@ func (gs *GameplayScreen) Update() error {
 					}
 				}
 			} else if ebiten.IsKeyPressed(ebiten.KeyArrowDown) {
-				gs.playerCar.VelocityY -= gs.playerCar.Acceleration * 3.0
+				if gs.playerCar.VelocityY > 0 {
+					gs.wearBrakes(gs.playerCar.VelocityY / (100.0 / MPHPerPixelPerFrame))
+					gs.score.Brake()
+				}
+				gs.playerCar.VelocityY -= gs.playerCar.BrakeForce
 				if gs.playerCar.VelocityY < minSpeed {
 					gs.playerCar.VelocityY = minSpeed
 				}
@@ -1348,8 +1538,12 @@ func (gs *GameplayScreen) Update() error {
 
 		// Apply "grip" or inertia: Interpolate current VelocityX towards target
 		// Lower grip factor = more drift/slide (0.0 = ice, 1.0 = instant turn)
-		gripFactor := 0.2
+		gripFactor := gs.playerCar.Grip
 		gs.playerCar.VelocityX += (targetVelocityX - gs.playerCar.VelocityX) * gripFactor
+
+		// A tired driver wanders across the lane, and bent steering pulls the car to one side
+		gs.playerCar.VelocityX += gs.tiredDrift()
+		gs.playerCar.VelocityX += gs.playerCar.Pull * alignmentPull * speedFactor
 	}
 
 	// Update car position based on velocity
@@ -1393,6 +1587,11 @@ func (gs *GameplayScreen) Update() error {
 		}
 	}
 
+	// HGVs are kept out of the fast lanes
+	if gs.playerCar.SelectedCar.IsHGV() {
+		rightEdge = min(rightEdge, gs.hgvRightEdge(segmentIdx, laneWidth))
+	}
+
 	if gs.playerCar.X < leftEdge+10 {
 		gs.playerCar.X = leftEdge + 10
 		gs.playerCar.VelocityX = 0
@@ -1421,16 +1620,18 @@ func (gs *GameplayScreen) Update() error {
 	// MPH = Miles per Hour. At 60 FPS: Miles per Frame = MPH / 216000
 	currentSpeedMPH := gs.playerCar.VelocityY * MPHPerPixelPerFrame
 	gs.DistanceTravelled += currentSpeedMPH / 216000.0
+	gs.wallet.Earn(currentSpeedMPH / 216000.0 * economy.IncomePerMile)
+	gs.addCleanMiles(currentSpeedMPH / 216000.0)
+
+	// The meter runs on the same miles, and a tired cabbie's shift is over
+	if gs.updateTaxi(segmentIdx, currentSpeedMPH/216000.0) {
+		return nil
+	}
 
 	// Consume fuel based on speed
 	// Base burn + speed factor (Tuned for ~5 mins driving)
 	fuelBurn := 0.0002 + gs.playerCar.VelocityY*0.0003
-	if gs.playerCar.SelectedCar.FuelLevel > 0 {
-		gs.playerCar.SelectedCar.FuelLevel -= fuelBurn
-		if gs.playerCar.SelectedCar.FuelLevel < 0 {
-			gs.playerCar.SelectedCar.FuelLevel = 0
-		}
-	}
+	gs.fuelUsed += gs.playerCar.SelectedCar.BurnFuel(fuelBurn)
 
 	// Consume sleep (slower than fuel)
 	sleepBurn := 0.0001 + gs.playerCar.VelocityY*0.00005
@@ -1457,6 +1658,9 @@ func (gs *GameplayScreen) Update() error {
 		gs.ToiletLevel = 100.0
 	}
 
+	// Running out of any of them has consequences
+	gs.updateNeeds()
+
 	// Scroll the road (move road downward to create forward movement illusion)
 	scrollSpeed := gs.playerCar.VelocityY
 
@@ -1465,21 +1669,31 @@ func (gs *GameplayScreen) Update() error {
 
 	// Update traffic
 	gs.updateTraffic(scrollSpeed, currentSegment, laneWidth)
+	gs.updatePolice()
 
-	// Check for collisions with traffic
-	if gs.checkCollisions() {
+	// Check for collisions with traffic (ignored for a moment after a respawn)
+	if hit := gs.checkCollisions(); hit != nil && gs.ticks >= gs.respawnGraceUntil {
 		// Crash handling
-		now := time.Now().UnixMilli()
+		now := gs.nowMillis()
 		// Debounce: only count crash every 1 second to prevent rapid incrementing during sustained contact
 		if now-gs.lastCrashTime > 1000 {
 			gs.Crashes++
 			gs.lastCrashTime = now
 
-			// Game Over check
+			// Game Over check, otherwise respawn after a bad enough crash
+			impactMPH := math.Abs(gs.playerCar.VelocityY-hit.VelocityY) * MPHPerPixelPerFrame
+			gs.damageCar(hit, impactMPH)
+			gs.penaliseXP(progression.XPCrashPenalty)
+			gs.scoreCrash(hit)
+			gs.breakFragileCargo()
+			gs.upsetPassengers()
+			gs.taxiCollision()
+			gs.rammedPolice(hit)
 			if gs.Crashes >= 10 {
-				if gs.onGameEnd != nil {
-					gs.onGameEnd()
-				}
+				gs.endRun(ui.RunGameOver)
+			} else if gs.shouldRespawn(impactMPH) {
+				gs.respawnAtCheckpoint()
+				return nil
 			}
 		}
 
@@ -1487,6 +1701,7 @@ func (gs *GameplayScreen) Update() error {
 		gs.playerCar.VelocityY *= -0.5
 		gs.playerCar.VelocityX *= -0.5
 	}
+	gs.updateNearMisses()
 
 	// Remove segments that have scrolled off screen
 	// DISABLED: Removing segments causes issues with visibility at the edges.
@@ -1512,19 +1727,16 @@ func (gs *GameplayScreen) Update() error {
 	// All segments are pre-generated from level data, no dynamic addition needed
 
 	// Check Petrol Stations
+	stoppedAt := -1
 	if math.Abs(gs.playerCar.VelocityY) < 0.5 { // Stopped or very slow
-		for _, station := range gs.petrolStations {
+		for i, station := range gs.petrolStations {
 			dist := math.Hypot(gs.playerCar.X-station.X, gs.playerCar.Y-station.Y)
 			if dist < 80 {
-				// Refuel
-				if gs.playerCar.SelectedCar.FuelLevel < gs.playerCar.SelectedCar.FuelCapacity {
-					gs.playerCar.SelectedCar.FuelLevel += 0.5
-					if gs.playerCar.SelectedCar.FuelLevel > gs.playerCar.SelectedCar.FuelCapacity {
-						gs.playerCar.SelectedCar.FuelLevel = gs.playerCar.SelectedCar.FuelCapacity
-					}
-				}
+				// Buy fuel, food or a bed, whichever the station sells
+				stoppedAt = i
+				gs.useService(i, station)
 
-				// Use restroom (relieve toilet level)
+				// Use restroom (relieve toilet level); free at every stop
 				if gs.ToiletLevel > 0 {
 					gs.ToiletLevel -= 50.0 // Significant relief
 					if gs.ToiletLevel < 0 {
@@ -1534,6 +1746,12 @@ func (gs *GameplayScreen) Update() error {
 			}
 		}
 	}
+	gs.atStation = stoppedAt
+	if stoppedAt < 0 {
+		gs.stopRewarded = false
+		gs.pumpShown = false
+	}
+	gs.updateTachograph()
 
 	return nil
 }
@@ -1549,14 +1767,18 @@ func (gs *GameplayScreen) Draw(screen *ebiten.Image) {
 	gs.drawPetrolStationTarmac(screen)
 	gs.drawRoad(screen)
 	gs.drawPetrolStations(screen)
+	gs.drawSpeedCameras(screen)
 
-	// Draw billboards
+	// Draw billboards and the hitchhikers waiting at the laybys
 	gs.drawBillboards(screen)
+	gs.drawHitchhikers(screen)
+	gs.drawTaxiCustomers(screen)
 
 	// Draw traffic (behind player car)
 	gs.drawTraffic(screen)
 
-	// Draw player car
+	// Draw the time trial ghost, then player car
+	gs.drawGhost(screen)
 	gs.drawCar(screen)
 
 	if gs.onFoot && gs.playerPed != nil {
@@ -1565,11 +1787,56 @@ func (gs *GameplayScreen) Draw(screen *ebiten.Image) {
 
 	// Draw UI overlay
 	gs.drawUI(screen)
+	gs.drawScore(screen)
+	gs.drawTimeTrial(screen)
+	gs.drawTachograph(screen)
+	gs.drawPolice(screen)
+	gs.drawWanted(screen)
+	gs.drawContracts(screen)
+	gs.drawPassengers(screen)
+	gs.drawTaxiMeter(screen)
 
 	// Draw pause menu on top
 	if gs.paused {
 		gs.drawPauseMenu(screen)
 	}
+
+	gs.drawKeyHints(screen)
+	gs.drawNeeds(screen)
+	gs.drawToasts(screen)
+
+	if gs.petrolScreen != nil {
+		gs.petrolScreen.Draw(screen)
+	}
+	if gs.contractBoard != nil {
+		gs.contractBoard.Draw(screen)
+	}
+}
+
+// drawKeyHints lists the keys that do something where the player is, one line each, stacked up from the
+// bottom of the screen
+func (gs *GameplayScreen) drawKeyHints(screen *ebiten.Image) {
+	if gs.paused {
+		return
+	}
+
+	hints := make([]string, 0)
+	if gs.canSaveAtLayby() {
+		hints = append(hints, "S: SAVE GAME")
+	}
+	if gs.contractBoard == nil && gs.canOfferContracts() {
+		hints = append(hints, "C: CONTRACTS BOARD")
+	}
+	if gs.petrolScreen == nil && gs.canRepair() {
+		hints = append(hints, fmt.Sprintf("F: REPAIR CAR $%.2f", economy.RepairBill(gs.playerCar.SelectedCar)))
+	}
+	if hint := gs.breakdownHint(); hint != "" {
+		hints = append(hints, hint)
+	}
+
+	for i, hint := range hints {
+		ebitenutil.DebugPrintAt(screen, hint, gs.screenWidth/2-len(hint)*3, gs.screenHeight-25-20*i)
+	}
 }
 
 // drawBackground renders the base grass layer
@@ -2031,11 +2298,24 @@ func (gs *GameplayScreen) drawTree(screen *ebiten.Image, x, y float64, seed int,
 
 // drawCar renders the player's car
 func (gs *GameplayScreen) drawCar(screen *ebiten.Image) {
+	gs.drawCarSprite(screen, gs.playerCar.X, gs.playerCar.Y, gs.playerCar.SteeringAngle, 1)
+
+	// Draw steering wheel indicator in bottom-right corner
+	gs.drawSteeringIndicator(screen)
+}
+
+// drawCarSprite draws the player's car sprite centred on world position x, y, turned by steering
+// and faded to alpha. The time trial ghost is drawn with it too.
+func (gs *GameplayScreen) drawCarSprite(screen *ebiten.Image, x, y, steering float64, alpha float32) {
+	if gs.playerCar.SelectedCar.IsHGV() {
+		gs.drawTruckSprite(screen, x, y, steering, alpha)
+		return
+	}
 	carWidth, carHeight := 40, 64
 
 	// Car position on screen (convert world X to screen X with camera offset)
-	screenX := gs.playerCar.X - gs.cameraX - float64(carWidth)/2
-	screenY := gs.playerCar.Y - gs.cameraY - float64(carHeight)/2
+	screenX := x - gs.cameraX - float64(carWidth)/2
+	screenY := y - gs.cameraY - float64(carHeight)/2
 
 	// Create improved retro car sprite
 	carImg := ebiten.NewImage(carWidth, carHeight)
@@ -2140,16 +2420,14 @@ func (gs *GameplayScreen) drawCar(screen *ebiten.Image) {
 	op := &ebiten.DrawImageOptions{}
 
 	// Rotate car sprite based on steering angle (subtle rotation)
-	rotationAngle := gs.playerCar.SteeringAngle * 0.15             // Max 15 degrees rotation
+	rotationAngle := steering * 0.15                               // Max 15 degrees rotation
 	op.GeoM.Translate(-float64(carWidth)/2, -float64(carHeight)/2) // Center rotation
 	op.GeoM.Rotate(rotationAngle)
 	op.GeoM.Translate(float64(carWidth)/2, float64(carHeight)/2)
 
 	op.GeoM.Translate(screenX, screenY)
+	op.ColorScale.ScaleAlpha(alpha)
 	screen.DrawImage(carImg, op)
-
-	// Draw steering wheel indicator in bottom-right corner
-	gs.drawSteeringIndicator(screen)
 }
 
 // drawSteeringIndicator draws a visual indicator of the steering wheel position
@@ -2290,52 +2568,43 @@ func (gs *GameplayScreen) getCurrentLane(segment RoadSegment, laneWidth float64)
 	return renderedLaneIndex
 }
 
-// checkCollisions checks if the player car collides with any traffic vehicles
-func (gs *GameplayScreen) checkCollisions() bool {
-	// Use smaller collision boxes than the actual car size to allow maneuvering between cars
-	// Actual car size is 40x64, but we'll use smaller collision boxes
-	collisionWidth := 30.0  // Smaller than 40px car width
-	collisionHeight := 50.0 // Smaller than 64px car height
-
-	// Player car is drawn at fixed screen position (screenHeight - 150 = 450)
-	// Traffic cars are drawn at: screenY = tc.Y - gs.playerCar.Y + screenHeight/2
-	// For collision at same screen Y: 450 = tc.Y - gs.playerCar.Y + 300
-	// Therefore: tc.Y = gs.playerCar.Y + 150
-	// We need to check if traffic Y is within collisionHeight of this position
-
-	// Player car world X position (using smaller collision box)
-	playerLeft := gs.playerCar.X - collisionWidth/2
-	playerRight := gs.playerCar.X + collisionWidth/2
-
-	// Player car's effective world Y for collision
-	// Both player and traffic use the same world coordinate system
-	playerCollisionY := gs.playerCar.Y
-	playerYTop := playerCollisionY - collisionHeight/2
-	playerYBottom := playerCollisionY + collisionHeight/2
+// Collision boxes are smaller than the actual 40x64 car size to allow maneuvering between cars
+const (
+	collisionWidth  = 30.0
+	collisionHeight = 50.0
+)
 
-	// Check collision with each traffic vehicle
+// checkCollisions returns the traffic vehicle the player car collides with, or nil
+func (gs *GameplayScreen) checkCollisions() *TrafficCar {
 	gs.trafficMutex.RLock()
-	for _, tc := range gs.traffic {
-		// Traffic car world X position (using smaller collision box)
-		trafficLeft := tc.X - collisionWidth/2
-		trafficRight := tc.X + collisionWidth/2
-
-		// Traffic car world Y bounding box (using smaller collision box)
-		trafficYTop := tc.Y - collisionHeight/2
-		trafficYBottom := tc.Y + collisionHeight/2
+	defer gs.trafficMutex.RUnlock()
 
-		// Check X overlap
-		if playerLeft < trafficRight && playerRight > trafficLeft {
-			// Check Y overlap (traffic bounding box overlaps with player collision range)
-			if trafficYTop < playerYBottom && trafficYBottom > playerYTop {
-				gs.trafficMutex.RUnlock()
-				return true // Collision detected
-			}
+	for _, tc := range gs.traffic {
+		if gs.overlapsPlayer(tc, 0) {
+			return tc // Collision detected
 		}
 	}
-	gs.trafficMutex.RUnlock()
+	return nil
+}
 
-	return false
+// overlapsPlayer reports whether tc's collision box, grown by margin on every side, overlaps the player's.
+// Both player and traffic use the same world coordinate system.
+func (gs *GameplayScreen) overlapsPlayer(tc *TrafficCar, margin float64) bool {
+	// Player car world bounding box (using smaller collision box)
+	playerLeft := gs.playerCar.X - collisionWidth/2
+	playerRight := gs.playerCar.X + collisionWidth/2
+	playerYTop := gs.playerCar.Y - gs.playerCollisionHeight()/2
+	playerYBottom := gs.playerCar.Y + gs.playerCollisionHeight()/2
+
+	// Traffic car world bounding box, grown by the margin
+	trafficLeft := tc.X - collisionWidth/2 - margin
+	trafficRight := tc.X + collisionWidth/2 + margin
+	trafficYTop := tc.Y - tc.collisionHeight()/2 - margin
+	trafficYBottom := tc.Y + tc.collisionHeight()/2 + margin
+
+	// Check X overlap, then Y overlap
+	return playerLeft < trafficRight && playerRight > trafficLeft &&
+		trafficYTop < playerYBottom && trafficYBottom > playerYTop
 }
 
 // getSegmentAtY finds the road segment at a given world Y position
@@ -2353,19 +2622,9 @@ func (gs *GameplayScreen) getSegmentAtY(y float64) RoadSegment {
 	return RoadSegment{LaneCount: 1, RoadTypes: []string{"A"}, StartLaneIndex: 0, Y: 0}
 }
 
-// resetToStart resets the player to the start of the level
+// resetToStart resets the player to the start of the level with no crashes and fresh needs.
+// Crash respawns use the same path, but from the last checkpoint (see respawnAtCheckpoint).
 func (gs *GameplayScreen) resetToStart() {
-	// Reset player position
-	gs.playerCar.X = gs.initialX
-	gs.playerCar.Y = gs.initialY
-	gs.playerCar.VelocityX = 0
-	gs.playerCar.VelocityY = 0
-	gs.playerCar.SteeringAngle = 0
-	gs.cameraX = 0
-
-	// Clear all traffic
-	gs.cleanupTraffic()
-
 	// Reset crash counter
 	gs.Crashes = 0
 
@@ -2376,10 +2635,30 @@ func (gs *GameplayScreen) resetToStart() {
 
 	// Regenerate road from level data
 	gs.roadSegments = make([]RoadSegment, 0)
+	gs.petrolStations = make([]PetrolStation, 0)
+	gs.billboards = make([]Billboard, 0)
 	gs.generateRoadFromLevel(gs.levelData)
 
-	// Spawn initial traffic again
-	gs.spawnInitialTraffic()
+	// Back to the first checkpoint, clearing traffic and spawning it again
+	gs.checkpoint = gs.levelStartCheckpoint()
+	gs.respawnAtCheckpoint()
+}
+
+// ReloadLevel rebuilds the road, petrol stations and billboards from new level data
+// without moving the player, so level edits can be checked while driving
+func (gs *GameplayScreen) ReloadLevel(levelData *LevelData) {
+	gs.levelData = levelData
+
+	gs.roadSegments = make([]RoadSegment, 0)
+	gs.petrolStations = make([]PetrolStation, 0)
+	gs.billboards = make([]Billboard, 0)
+	gs.generateRoadFromLevel(levelData)
+
+	// The auto-pilot lane may no longer exist on the edited road
+	currentSegment, _ := gs.getCurrentRoadSegment()
+	if gs.autoDriveLane >= currentSegment.LaneCount {
+		gs.autoDriveLane = currentSegment.LaneCount - 1
+	}
 }
 
 // cleanupTraffic stops all AI goroutines and clears traffic
@@ -2422,9 +2701,10 @@ func (gs *GameplayScreen) updateTraffic(scrollSpeed float64, currentSegment Road
 			}
 			other := gs.traffic[j]
 
-			// Calculate distance
+			// Calculate distance, with Y scaled so an HGV's longer box counts as a car's
+			lengthScale := 2 * collisionHeight / (tc.collisionHeight() + other.collisionHeight())
 			dx := tc.X - other.X
-			dy := tc.Y - other.Y
+			dy := (tc.Y - other.Y) * lengthScale
 			dist := math.Hypot(dx, dy)
 
 			// Collision Radius (approx car length/width avg)
@@ -2482,7 +2762,7 @@ func (gs *GameplayScreen) updateTraffic(scrollSpeed float64, currentSegment Road
 
 					if overlap > slop {
 						correctionX := nx * overlap * percent
-						correctionY := ny * overlap * percent
+						correctionY := ny * overlap * percent / lengthScale
 
 						// Apply correction directly to position
 						tc.X += correctionX
@@ -2499,13 +2779,9 @@ func (gs *GameplayScreen) updateTraffic(scrollSpeed float64, currentSegment Road
 		if !tc.Passed && gs.playerCar.Y < tc.Y {
 			tc.Passed = true
 			gs.TotalCarsPassed++
-
-			// Level Up Logic
-			if gs.TotalCarsPassed >= gs.LevelThreshold {
-				gs.Level++
-				gs.PrevLevelThreshold = gs.LevelThreshold
-				gs.LevelThreshold = int(float64(gs.LevelThreshold) * 1.5)
-			}
+			gs.wallet.Earn(economy.IncomePerCarPassed)
+			gs.awardXP(progression.OvertakeXP())
+			gs.scoreOvertake(tc)
 		}
 
 		// Remove traffic that's too far off screen (beyond spawn range)
@@ -2529,17 +2805,18 @@ func (gs *GameplayScreen) spawnInitialTraffic() {
 		return
 	}
 
-	segment := gs.roadSegments[0]
+	// Seed around the player, which is the start of the level unless they have respawned
+	segment := gs.getSegmentAt(gs.playerCar.Y)
 	laneWidth := 80.0
 	playerY := gs.playerCar.Y
 
 	// Spawn traffic in each lane (skip lane 0)
 	for lane := 1; lane < segment.LaneCount; lane++ {
 		// Spawn at most one vehicle ahead and behind with probability to keep density low
-		if rand.Float64() < trafficSpawnProbability {
+		if gs.rng.Float64() < trafficSpawnProbability {
 			gs.spawnTrafficInDirection(segment, laneWidth, playerY, lane, true)
 		}
-		if rand.Float64() < trafficSpawnProbability {
+		if gs.rng.Float64() < trafficSpawnProbability {
 			gs.spawnTrafficInDirection(segment, laneWidth, playerY, lane, false)
 		}
 	}
@@ -2548,7 +2825,7 @@ func (gs *GameplayScreen) spawnInitialTraffic() {
 // spawnTraffic spawns traffic vehicles ahead and behind the player
 func (gs *GameplayScreen) spawnTraffic(segment RoadSegment, laneWidth float64, playerY float64) {
 	// Check cooldown before attempting to spawn
-	currentTime := time.Now().UnixMilli()
+	currentTime := gs.nowMillis()
 	if currentTime-gs.lastSpawnTime < gs.spawnCooldown {
 		return
 	}
@@ -2565,12 +2842,12 @@ func (gs *GameplayScreen) spawnTraffic(segment RoadSegment, laneWidth float64, p
 		baseProbability := trafficSpawnProbability
 
 		// Always try to spawn ahead first (more visible)
-		if rand.Float64() < baseProbability {
+		if gs.rng.Float64() < baseProbability {
 			gs.spawnTrafficInDirection(segment, laneWidth, playerY, lane, true)
 		}
 
 		// Lower chance to spawn behind
-		if rand.Float64() < baseProbability*0.4 {
+		if gs.rng.Float64() < baseProbability*0.4 {
 			gs.spawnTrafficInDirection(segment, laneWidth, playerY, lane, false)
 		}
 	}
@@ -2607,7 +2884,7 @@ func (gs *GameplayScreen) spawnTrafficInDirection(segment RoadSegment, laneWidth
 	}
 
 	// Generate a candidate spawn position uniformly in range
-	spawnY := minY + rand.Float64()*(maxY-minY)
+	spawnY := minY + gs.rng.Float64()*(maxY-minY)
 
 	// DENSITY CHECK: Increase minimum distance for faster lanes to prevent overcrowding
 	// Lane 1 (60mph) -> 150px
@@ -2688,7 +2965,15 @@ func (gs *GameplayScreen) spawnTrafficInDirection(segment RoadSegment, laneWidth
 		{200, 200, 50, 255},  // Yellow
 		{200, 100, 200, 255}, // Purple
 	}
-	carColor := colors[rand.Intn(len(colors))]
+	carColor := colors[gs.rng.Intn(len(colors))]
+	police := gs.rng.Float64() < policeChance && gs.policeEnforcing()
+	if police {
+		carColor = policeColor
+	}
+	hgv := !police && hgvLaneAllowed(segment, lane) && gs.rng.Float64() < hgvTrafficChance
+	if hgv {
+		trafficVelocityY = min(trafficVelocityY, hgvSpeedLimiterMPH/MPHPerPixelPerFrame)
+	}
 
 	// Safety check: Never spawn traffic in lane 0 (reserved for player)
 	if lane == 0 {
@@ -2704,60 +2989,24 @@ func (gs *GameplayScreen) spawnTrafficInDirection(segment RoadSegment, laneWidth
 		// Faster lanes: C3, C4, C5
 		allowedCategories = []string{"C3", "C4", "C5"}
 	}
+	if hgv {
+		allowedCategories = []string{car.CategoryHGV}
+	}
 
-	carModel := models.CarInventory.GetRandomCarByCategory(allowedCategories)
+	carModel := models.CarInventory.GetRandomCarByCategory(gs.rng, allowedCategories)
 
 	// Generate random name
 	nameList := data.CommonNames.Male
-	if rand.Float64() > 0.5 {
+	if gs.rng.Float64() > 0.5 {
 		nameList = data.CommonNames.Female
 	}
-	driverName := nameList[rand.Intn(len(nameList))]
-	id := fmt.Sprintf("%s-%d", driverName, rand.Intn(1000))
-
-	// Calculate physics properties from car stats
-	// 0-60 mph time -> acceleration
-	// Acceleration = DeltaV / Time
-	// 60mph = 60 * MPHPerPixelPerFrame pixels/frame? No.
-	// 60mph in pixels/frame = 60 / MPHPerPixelPerFrame = 60 / 9.6 = 6.25
-	// Time is in seconds. Frames = seconds * 60.
-	// Accel = 6.25 / (Accel0to60 * 60)
-	accel := 0.05 // Default fallback
-	if carModel.Accel0to60 > 0 {
-		targetVel := 60.0 / MPHPerPixelPerFrame
-		frames := carModel.Accel0to60 * 60.0
-		accel = targetVel / frames
-	}
-
-	// Braking efficiency -> deceleration
-	// Base decel is around 0.1
-	decel := 0.1 * (carModel.BrakingEfficiency / 0.6) // Normalized against 0.6 efficiency
-
-	// Determine headshot image
-	// Assuming ID string starts with a name, we can deterministically pick an image or store it in struct
-	// Since we need to store the *ebiten.Image in the struct, let's load it now.
-	// To avoid repeated IO, we should probably have a cache, but for now let's pick deterministically from assets.
-
-	// Map gender from name or just random? We used a name list.
-	// Let's assume we can infer or just pick randomly.
-	// Simple hash of ID to pick an image
-	hash := 0
-	for _, c := range id {
-		hash += int(c)
-	}
+	driverName := nameList[gs.rng.Intn(len(nameList))]
+	id := fmt.Sprintf("%s-%d", driverName, gs.rng.Intn(1000))
 
-	headshotIdx := hash % 8 // 4 men + 4 women
-	var headshotPath string
-	if headshotIdx < 4 {
-		headshotPath = fmt.Sprintf("assets/characters/headshots/man%d_headshot.png", headshotIdx+1)
-	} else {
-		headshotPath = fmt.Sprintf("assets/characters/headshots/woman%d_headshot.png", (headshotIdx-4)+1)
-	}
+	accel, decel := trafficHandling(carModel)
 
-	var headshotImg *ebiten.Image
-	if img, _, err := ebitenutil.NewImageFromFile(headshotPath); err == nil {
-		headshotImg = img
-	}
+	// Headshots are decoded once and cached by the asset manager
+	headshotImg, _ := assets.Default.Headshot(trafficCharacterID(id))
 
 	// Create new traffic car
 	newTraffic := &TrafficCar{
@@ -2769,8 +3018,9 @@ func (gs *GameplayScreen) spawnTrafficInDirection(segment RoadSegment, laneWidth
 		Deceleration:       decel,
 		Lane:               lane,
 		Color:              carColor,
-		Passed:             !ahead,                 // If spawned behind, it's already passed
-		LastLaneChangeTime: time.Now().UnixMilli(), // Initialize with spawn time
+		Police:             police,
+		Passed:             !ahead,         // If spawned behind, it's already passed
+		LastLaneChangeTime: gs.nowMillis(), // Initialize with spawn time
 
 		// New fields
 		ID:         id,
@@ -2785,6 +3035,41 @@ func (gs *GameplayScreen) spawnTrafficInDirection(segment RoadSegment, laneWidth
 	gs.trafficMutex.Unlock()
 }
 
+// trafficHandling calculates a traffic car's acceleration and deceleration (px/frame per frame) from its stats
+func trafficHandling(carModel *car.Car) (accel, decel float64) {
+	// 0-60 mph time -> acceleration
+	// Acceleration = DeltaV / Time
+	// 60mph in pixels/frame = 60 / MPHPerPixelPerFrame = 60 / 9.6 = 6.25
+	// Time is in seconds. Frames = seconds * 60.
+	// Accel = 6.25 / (Accel0to60 * 60)
+	accel = 0.05 // Default fallback
+	if carModel.Accel0to60 > 0 {
+		targetVel := 60.0 / MPHPerPixelPerFrame
+		frames := carModel.Accel0to60 * 60.0
+		accel = targetVel / frames
+	}
+
+	// Braking efficiency -> deceleration
+	// Base decel is around 0.1
+	decel = 0.1 * (carModel.BrakingEfficiency / 0.6) // Normalized against 0.6 efficiency
+	return accel, decel
+}
+
+// trafficCharacterID picks a driver's headshot character from a simple hash of their ID,
+// so the same driver always gets the same face (including after a snapshot is restored)
+func trafficCharacterID(id string) string {
+	hash := 0
+	for _, c := range id {
+		hash += int(c)
+	}
+
+	headshotIdx := hash % 8 // 4 men + 4 women
+	if headshotIdx < 4 {
+		return fmt.Sprintf("man%d", headshotIdx+1)
+	}
+	return fmt.Sprintf("woman%d", (headshotIdx-4)+1)
+}
+
 // drawTraffic renders all traffic vehicles
 func (gs *GameplayScreen) drawTraffic(screen *ebiten.Image) {
 	carWidth, carHeight := 40, 64
@@ -2812,6 +3097,11 @@ func (gs *GameplayScreen) drawTraffic(screen *ebiten.Image) {
 			continue
 		}
 
+		if tc.isHGV() {
+			gs.drawTruckSprite(screen, tc.X, tc.Y, tc.SteeringAngle, 1)
+			continue
+		}
+
 		// Create traffic car sprite (similar to player car but with different color)
 		carImg := ebiten.NewImage(carWidth, carHeight)
 
@@ -2921,6 +3211,10 @@ func (gs *GameplayScreen) drawTraffic(screen *ebiten.Image) {
 			}
 		}
 
+		if tc.Police {
+			drawPoliceLivery(carImg, tc.Pursuing, gs.ticks)
+		}
+
 		// Draw the traffic car
 		op := &ebiten.DrawImageOptions{}
 		op.GeoM.Translate(screenX, screenY)
@@ -2996,7 +3290,7 @@ func (gs *GameplayScreen) drawUI(screen *ebiten.Image) {
 	// Top right: Stats
 	// Draw background box for stats
 	statsWidth := 180.0
-	statsHeight := 320.0 // Increased height for toilet and crash bars
+	statsHeight := 340.0 // Increased height for toilet, crash and damage bars
 	x := float64(gs.screenWidth) - statsWidth - 20.0
 	y := 20.0
 
@@ -3032,13 +3326,20 @@ func (gs *GameplayScreen) drawUI(screen *ebiten.Image) {
 	textOp.ColorScale.ScaleWithColor(color.White)
 	text.Draw(screen, milesText, face, textOp)
 
+	// Draw Wallet, right-aligned on the same line
+	moneyText := fmt.Sprintf("$%.2f", gs.wallet.Balance)
+	moneyOp := &text.DrawOptions{}
+	moneyOp.GeoM.Translate(x+150-text.Advance(moneyText, face), y)
+	moneyOp.ColorScale.ScaleWithColor(color.RGBA{100, 255, 100, 255})
+	text.Draw(screen, moneyText, face, moneyOp)
+
 	// Draw Status Bars (Fuel, Food, Sleep)
 	barWidth := 150.0
 	barHeight := 12.0
 	spacing := 40.0 // Increased spacing (was 25.0)
 
 	// Fuel
-	fuelPercent := gs.playerCar.SelectedCar.FuelLevel / gs.playerCar.SelectedCar.FuelCapacity
+	fuelPercent := gs.playerCar.SelectedCar.FuelFraction()
 	gs.drawStatusBar(screen, x, y+spacing, barWidth, barHeight, fuelPercent, "FUEL", color.RGBA{255, 165, 0, 255}) // Orange
 
 	// Food
@@ -3053,18 +3354,12 @@ func (gs *GameplayScreen) drawUI(screen *ebiten.Image) {
 	toiletPercent := gs.ToiletLevel / 100.0
 	gs.drawStatusBar(screen, x, y+spacing*4, barWidth, barHeight, toiletPercent, "TOILET", color.RGBA{255, 165, 0, 255}) // Orange
 
-	// Level Progress Bar
-	levelProgress := float64(gs.TotalCarsPassed-gs.PrevLevelThreshold) / float64(gs.LevelThreshold-gs.PrevLevelThreshold)
-	if levelProgress < 0 {
-		levelProgress = 0
-	}
-	if levelProgress > 1 {
-		levelProgress = 1
+	// XP Bar
+	if progression.Rules.UI.ShowXPBar {
+		levelLabel := fmt.Sprintf("LEVEL %d  %d XP", gs.Level, gs.XP)
+		gs.drawStatusBar(screen, x, y+spacing*5, barWidth, barHeight, gs.levelProgress(), levelLabel, color.RGBA{255, 215, 0, 255}) // Gold
 	}
 
-	levelLabel := fmt.Sprintf("LEVEL %d", gs.Level)
-	gs.drawStatusBar(screen, x, y+spacing*5, barWidth, barHeight, levelProgress, levelLabel, color.RGBA{255, 215, 0, 255}) // Gold
-
 	// Crash Counter (now graphical)
 	crashPercent := float64(gs.Crashes) / 10.0
 	crashLabel := fmt.Sprintf("CRASHES %d/10", gs.Crashes)
@@ -3074,6 +3369,11 @@ func (gs *GameplayScreen) drawUI(screen *ebiten.Image) {
 	}
 	gs.drawStatusBar(screen, x, y+spacing*6, barWidth, barHeight, crashPercent, crashLabel, crashColor)
 
+	// Car damage (worst of bodywork, alignment, engine and brake wear)
+	damagePercent := gs.playerCar.SelectedCar.DamageLevel()
+	damageLabel := fmt.Sprintf("DAMAGE %.0f%%", damagePercent*100)
+	gs.drawStatusBar(screen, x, y+spacing*7, barWidth, barHeight, damagePercent, damageLabel, color.RGBA{255, 80, 80, 255}) // Red
+
 	// DEBUG: Traffic Counter
 	gs.trafficMutex.RLock()
 	totalCars := len(gs.traffic)
@@ -3154,7 +3454,7 @@ func (gs *GameplayScreen) drawPetrolStations(screen *ebiten.Image) {
 		textOp := &text.DrawOptions{}
 		textOp.GeoM.Translate(screenX, screenY-15)
 		textOp.ColorScale.ScaleWithColor(color.White)
-		text.Draw(screen, "FUEL", face, textOp)
+		text.Draw(screen, station.label(), face, textOp)
 	}
 }
 
@@ -3514,6 +3814,11 @@ func (gs *GameplayScreen) updatePed() {
 		gs.playerPed.Y += dy * speed
 	}
 
+	// Pick up a hitchhiker
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) && gs.pickUpHitchhiker() {
+		return
+	}
+
 	// Interaction with Traffic
 	gs.trafficMutex.Lock()
 	defer gs.trafficMutex.Unlock()
@@ -3535,6 +3840,7 @@ func (gs *GameplayScreen) updatePed() {
 			gs.playerCar.VelocityX = 0
 			gs.playerCar.VelocityY = 0
 			// TODO: Change color/sprite of player car?
+			gs.stealCar(tc)
 
 			// Remove traffic car
 			gs.traffic = append(gs.traffic[:i], gs.traffic[i+1:]...)
@@ -3580,14 +3886,28 @@ func (gs *GameplayScreen) updatePauseMenu() error {
 		}
 	}
 
-	// Exit Button (Center Y + 110)
+	// Save Button (Center Y + 110)
 	if mx >= centerX-btnW/2 && mx <= centerX+btnW/2 &&
 		my >= centerY+110-btnH/2 && my <= centerY+110+btnH/2 {
 		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
-			// Exit to title
-			if gs.onGameEnd != nil {
-				gs.onGameEnd()
-			}
+			gs.saveGame(SnapshotPause)
+		}
+	}
+
+	// Exit Button (Center Y + 170)
+	if mx >= centerX-btnW/2 && mx <= centerX+btnW/2 &&
+		my >= centerY+170-btnH/2 && my <= centerY+170+btnH/2 {
+		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+			// Exit to the results screen
+			gs.endRun(ui.RunQuit)
+		}
+	}
+
+	// Difficulty Button (Center Y + 230) cycles through the settings
+	if mx >= centerX-btnW/2 && mx <= centerX+btnW/2 &&
+		my >= centerY+230-btnH/2 && my <= centerY+230+btnH/2 {
+		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+			gs.cycleDifficulty()
 		}
 	}
 
@@ -3687,13 +4007,15 @@ func (gs *GameplayScreen) drawPauseMenu(screen *ebiten.Image) {
 	text.Draw(screen, carsText, face, cOp)
 
 	// Level
-	levelText := fmt.Sprintf("LEVEL: %d", gs.Level)
-	lW := text.Advance(levelText, face) * statsScale
-	lOp := &text.DrawOptions{}
-	lOp.GeoM.Scale(statsScale, statsScale)
-	lOp.GeoM.Translate(centerX-lW/2, statsY+60)
-	lOp.ColorScale.ScaleWithColor(color.RGBA{255, 215, 0, 255})
-	text.Draw(screen, levelText, face, lOp)
+	if progression.Rules.UI.ShowLevelIndicator {
+		levelText := fmt.Sprintf("LEVEL: %d", gs.Level)
+		lW := text.Advance(levelText, face) * statsScale
+		lOp := &text.DrawOptions{}
+		lOp.GeoM.Scale(statsScale, statsScale)
+		lOp.GeoM.Translate(centerX-lW/2, statsY+60)
+		lOp.ColorScale.ScaleWithColor(color.RGBA{255, 215, 0, 255})
+		text.Draw(screen, levelText, face, lOp)
+	}
 
 	// Buttons
 	// Helper to draw button
@@ -3727,5 +4049,7 @@ func (gs *GameplayScreen) drawPauseMenu(screen *ebiten.Image) {
 	}
 
 	drawButton("RESUME", centerY+50)
-	drawButton("EXIT", centerY+110)
+	drawButton("SAVE", centerY+110)
+	drawButton("EXIT", centerY+170)
+	drawButton(strings.ToUpper(gs.difficulty), centerY+230)
 }
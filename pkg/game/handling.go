@@ -0,0 +1,64 @@
+package game
+
+import "math"
+
+// The player's handling is tuned around a typical C1 hatchback; other cars scale from these figures
+const (
+	referenceBHPPerKg      = 0.105 // Power to weight of the reference car
+	referenceStoppingPower = 0.6   // Effective stopping power of the reference car, with the new brakes cars come with
+
+	baseAcceleration = 0.05 // px/frame per frame under throttle
+	baseBrakeForce   = 0.15 // px/frame per frame under braking
+	baseGrip         = 0.2  // How quickly VelocityX follows the steering (0 = ice, 1 = instant)
+)
+
+// applyCarStats sets the player's acceleration, braking, grip, top speed and pull from the selected car's
+// effective stats, so its weight, power, fitted upgrades and damage change how it drives
+func (gs *GameplayScreen) applyCarStats() {
+	selectedCar := gs.playerCar.SelectedCar
+	if selectedCar == nil {
+		return
+	}
+	stats := selectedCar.EffectiveStats()
+
+	// Acceleration follows the square root of power to weight, so supercars are quick without being uncontrollable
+	powerToWeight := stats.BHP / max(selectedCar.Weight, 1)
+	accelFactor := math.Sqrt(powerToWeight / referenceBHPPerKg)
+	minAccelFactor := 0.7
+	if selectedCar.IsHGV() {
+		minAccelFactor = hgvMinAccelFactor
+	}
+	gs.playerCar.Acceleration = baseAcceleration * max(minAccelFactor, min(2.0, accelFactor))
+
+	brakeFactor := stats.StoppingPower / referenceStoppingPower
+	gs.playerCar.BrakeForce = baseBrakeForce * max(0.5, min(2.0, brakeFactor))
+
+	gs.playerCar.Grip = min(0.5, baseGrip*stats.Grip)
+	gs.playerCar.TopSpeedFactor = stats.TopSpeedFactor
+	gs.playerCar.Pull = stats.Pull
+}
+
+// Damage and wear tuning
+const (
+	brakeWearPerTick = 0.00002 // Brake condition lost per tick of braking at the reference top speed
+	alignmentPull    = 1.5     // Sideways drift, px/frame, from fully bent steering at the reference top speed
+)
+
+// damageCar damages the player's car in a collision with hit
+func (gs *GameplayScreen) damageCar(hit *TrafficCar, impactMPH float64) {
+	// Hitting a car ahead is a front-end impact; the steering is knocked away from the side that was hit
+	frontal := hit.Y < gs.playerCar.Y
+	pull := 1.0
+	if hit.X > gs.playerCar.X {
+		pull = -1.0
+	}
+
+	gs.playerCar.SelectedCar.Crash(impactMPH, frontal, pull)
+	gs.applyCarStats()
+}
+
+// wearBrakes wears the player's brakes for a tick of braking at speedFactor of the reference top speed
+func (gs *GameplayScreen) wearBrakes(speedFactor float64) {
+	gs.playerCar.SelectedCar.WearBrakes(brakeWearPerTick * speedFactor)
+	gs.applyCarStats()
+}
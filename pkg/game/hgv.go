@@ -0,0 +1,257 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/golangdaddy/roadster/pkg/tachograph"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// HGVs are long, heavy and kept to the slow lanes, whichever mode they are driven in
+const (
+	hgvSpeedLimiterMPH = 56.0  // Every HGV is governed to this speed
+	hgvMinAccelFactor  = 0.3   // Lower bound on the power-to-weight scaling of acceleration (cars stop at 0.7)
+	hgvMaxLanePosition = 2     // Rightmost lane position an HGV may use; 0 is the layby, so the two slowest running lanes
+	hgvCollisionHeight = 130.0 // Collision box length; the width is a car's
+	hgvTrafficChance   = 0.1   // Share of traffic spawned in the HGV lanes that is an HGV
+	hgvSpriteWidth     = 44
+	hgvSpriteHeight    = 150
+)
+
+// haulage is an HGV mode run: the tachograph and what it has cost the driver
+type haulage struct {
+	tacho  tachograph.Tachograph
+	breaks int
+	fines  float64
+}
+
+// hgvTruck picks the haulier's truck for a level. The same level always gets the same truck, with a full tank.
+func hgvTruck(levelName string) *car.Car {
+	h := fnv.New64a()
+	h.Write([]byte("hgv/" + levelName))
+	truck := models.CarInventory.GetRandomCarByCategory(rand.New(rand.NewSource(int64(h.Sum64()))), []string{car.CategoryHGV}).Clone()
+	truck.FuelLevel = truck.TankCapacity()
+	return truck
+}
+
+// startHGV makes the run an HGV run, with the tachograph starting after a full break
+func (gs *GameplayScreen) startHGV() {
+	gs.mode = ModeHGV
+	gs.hgv = &haulage{}
+}
+
+// isRestStop reports whether a service is somewhere an HGV driver can take a tachograph break:
+// the same beds that restore SleepLevel, apart from the campsites lorries cannot get into
+func isRestStop(serviceType int) bool {
+	switch serviceType {
+	case road.ServiceTypeHotel, road.ServiceTypeMotel, road.ServiceTypeRVPark:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateTachograph records a tick of driving or of resting at a rest stop, fining the driver for every
+// infringement of the driving limit
+func (gs *GameplayScreen) updateTachograph() {
+	h := gs.hgv
+	if h == nil {
+		return
+	}
+
+	if math.Abs(gs.playerCar.VelocityY) >= 0.5 {
+		switch h.tacho.Drive() {
+		case tachograph.Infringement:
+			fine := gs.wallet.ChargeUpTo(tachograph.Fine)
+			h.fines += fine
+			gs.showToast("TACHOGRAPH: OVER THE DRIVING LIMIT - FINED $%.0f", fine)
+		default:
+			if h.tacho.Remaining() == tachograph.WarningTime {
+				gs.showToast("BREAK DUE IN %s - REST AT A HOTEL, MOTEL OR RV PARK", tachograph.Format(tachograph.WarningTime))
+			}
+		}
+		return
+	}
+
+	if gs.atStation >= 0 && gs.atStation < len(gs.petrolStations) && isRestStop(gs.petrolStations[gs.atStation].ServiceType) {
+		if h.tacho.Rest() == tachograph.BreakTaken {
+			h.breaks++
+			gs.showToast("BREAK TAKEN - TACHOGRAPH RESET")
+		}
+	}
+}
+
+// hgvResults fills in the run's tachograph record
+func (gs *GameplayScreen) hgvResults(r *ui.RunResults) {
+	r.HGV = true
+	r.Breaks = gs.hgv.breaks
+	r.Infringements = gs.hgv.tacho.Infringements
+	r.Fines = gs.hgv.fines
+}
+
+// hgvMaxSpeed caps maxSpeed (px/frame) at the speed limiter if the player is driving an HGV
+func (gs *GameplayScreen) hgvMaxSpeed(maxSpeed float64) float64 {
+	if !gs.playerCar.SelectedCar.IsHGV() {
+		return maxSpeed
+	}
+	return min(maxSpeed, hgvSpeedLimiterMPH/MPHPerPixelPerFrame)
+}
+
+// hgvRightEdge returns the world X of the right edge of the lanes an HGV may use at the player's position,
+// interpolated towards the next segment like the road edges
+func (gs *GameplayScreen) hgvRightEdge(segmentIdx int, laneWidth float64) float64 {
+	if segmentIdx < 0 || segmentIdx >= len(gs.roadSegments) {
+		return math.Inf(1)
+	}
+	segment := gs.roadSegments[segmentIdx]
+	edge := hgvLanesEdge(segment, laneWidth)
+	if segmentIdx < len(gs.roadSegments)-1 {
+		progress := max(0, min(1, (segment.Y-gs.playerCar.Y)/600.0))
+		edge += (hgvLanesEdge(gs.roadSegments[segmentIdx+1], laneWidth) - edge) * progress
+	}
+	return edge
+}
+
+// hgvLanesEdge returns the right edge of a segment's HGV lanes; a road too narrow to have any is all theirs
+func hgvLanesEdge(segment RoadSegment, laneWidth float64) float64 {
+	lanes := 0
+	for _, position := range segment.LanePositions {
+		if position <= hgvMaxLanePosition {
+			lanes++
+		}
+	}
+	return -float64(segment.StartLaneIndex)*laneWidth + float64(max(1, lanes))*laneWidth
+}
+
+// hgvLaneAllowed reports whether an HGV may drive in lane of segment
+func hgvLaneAllowed(segment RoadSegment, lane int) bool {
+	position := lane
+	if lane < len(segment.LanePositions) {
+		position = segment.LanePositions[lane]
+	}
+	return position <= hgvMaxLanePosition
+}
+
+// playerCollisionHeight is the length of the player's collision box
+func (gs *GameplayScreen) playerCollisionHeight() float64 {
+	if gs.playerCar.SelectedCar != nil && gs.playerCar.SelectedCar.IsHGV() {
+		return hgvCollisionHeight
+	}
+	return collisionHeight
+}
+
+// isHGV reports whether a traffic vehicle is an HGV
+func (tc *TrafficCar) isHGV() bool {
+	return tc.CarModel != nil && tc.CarModel.IsHGV()
+}
+
+// collisionHeight is the length of a traffic vehicle's collision box
+func (tc *TrafficCar) collisionHeight() float64 {
+	if tc.isHGV() {
+		return hgvCollisionHeight
+	}
+	return collisionHeight
+}
+
+// gapTo returns the distance along the road between tc and other, measured as if both were cars,
+// so the following distances tuned for cars leave the same room behind and in front of an HGV
+func (tc *TrafficCar) gapTo(other *TrafficCar) float64 {
+	return math.Abs(tc.Y-other.Y) - (tc.collisionHeight()+other.collisionHeight())/2 + collisionHeight
+}
+
+// drawTachograph shows the driving time since the last break, or the break in progress, under the score
+func (gs *GameplayScreen) drawTachograph(screen *ebiten.Image) {
+	if gs.hgv == nil {
+		return
+	}
+	t := gs.hgv.tacho
+	face := text.NewGoXFace(bitmapfont.Face)
+
+	label := fmt.Sprintf("TACHO  DRIVING %s / %s", tachograph.Format(t.Driving), tachograph.Format(tachograph.DrivingLimit))
+	clr := color.RGBA{255, 255, 255, 255}
+	switch {
+	case t.Resting > 0:
+		label = fmt.Sprintf("TACHO  BREAK %s / %s", tachograph.Format(t.Resting), tachograph.Format(tachograph.BreakLength))
+		clr = color.RGBA{120, 220, 255, 255}
+	case t.Remaining() < 0:
+		clr = color.RGBA{255, 80, 80, 255}
+	case t.Remaining() <= tachograph.WarningTime:
+		clr = color.RGBA{255, 215, 0, 255}
+	}
+
+	scale := 1.5
+	op := &text.DrawOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(float64(gs.screenWidth)/2-text.Advance(label, face)*scale/2, 70)
+	op.ColorScale.ScaleWithColor(clr)
+	text.Draw(screen, label, face, op)
+}
+
+// drawTruckSprite draws an articulated lorry centred on world position x, y, turned by steering and faded to alpha
+func (gs *GameplayScreen) drawTruckSprite(screen *ebiten.Image, x, y, steering float64, alpha float32) {
+	if gs.truckSprite == nil {
+		gs.truckSprite = createTruckSprite()
+	}
+
+	op := &ebiten.DrawImageOptions{}
+
+	// Long vehicles turn less for the same steering
+	op.GeoM.Translate(-hgvSpriteWidth/2, -hgvSpriteHeight/2)
+	op.GeoM.Rotate(steering * 0.06)
+	op.GeoM.Translate(x-gs.cameraX, y-gs.cameraY)
+	op.ColorScale.ScaleAlpha(alpha)
+	screen.DrawImage(gs.truckSprite, op)
+}
+
+// createTruckSprite generates a top-down tractor unit and trailer, cab first
+func createTruckSprite() *ebiten.Image {
+	img := ebiten.NewImage(hgvSpriteWidth, hgvSpriteHeight)
+	fill := func(x0, y0, x1, y1 int, clr color.RGBA) {
+		for y := y0; y < y1; y++ {
+			for x := x0; x < x1; x++ {
+				img.Set(x, y, clr)
+			}
+		}
+	}
+
+	wheel := color.RGBA{40, 40, 40, 255}
+	border := color.RGBA{0, 0, 0, 255}
+
+	// Tractor unit
+	fill(4, 4, 40, 34, color.RGBA{30, 80, 180, 255})
+	fill(8, 6, 36, 14, color.RGBA{100, 180, 220, 255}) // Windscreen
+	fill(6, 2, 12, 5, color.RGBA{255, 255, 100, 255})  // Headlights
+	fill(32, 2, 38, 5, color.RGBA{255, 255, 100, 255})
+	fill(0, 10, 4, 18, wheel)
+	fill(40, 10, 44, 18, wheel)
+
+	// Trailer
+	fill(2, 38, 42, 146, color.RGBA{190, 190, 190, 255})
+	fill(2, 90, 42, 94, color.RGBA{220, 60, 20, 255}) // Livery stripe
+	for _, axle := range []int{116, 126, 136} {
+		fill(0, axle, 2, axle+7, wheel)
+		fill(42, axle, 44, axle+7, wheel)
+	}
+	fill(6, 146, 12, 149, color.RGBA{255, 0, 0, 255}) // Tail lights
+	fill(32, 146, 38, 149, color.RGBA{255, 0, 0, 255})
+
+	for y := 38; y < 146; y++ {
+		img.Set(2, y, border)
+		img.Set(41, y, border)
+	}
+	for x := 2; x < 42; x++ {
+		img.Set(x, 38, border)
+		img.Set(x, 145, border)
+	}
+	return img
+}
@@ -0,0 +1,164 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"io/fs"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// levelPollInterval is how many ticks pass between checks of the level files (~0.5s at 60 FPS)
+const levelPollInterval = 30
+
+// reloadErrorDuration is how long a failed reload stays on screen
+const reloadErrorDuration = 8 * time.Second
+
+// levelWatcher polls the level directory for files whose modification time has changed.
+// Embedded assets never change, so edits are only picked up from an asset override directory.
+type levelWatcher struct {
+	fsys     fs.FS
+	pattern  string
+	modTimes map[string]time.Time
+	ticks    int
+	scanned  bool
+}
+
+// newLevelWatcher creates a watcher and records the current state of all matching files
+func newLevelWatcher(fsys fs.FS, pattern string) *levelWatcher {
+	w := &levelWatcher{
+		fsys:     fsys,
+		pattern:  pattern,
+		modTimes: make(map[string]time.Time),
+	}
+	w.scan()
+	return w
+}
+
+// scan stats every matching file and returns the ones that changed since the last scan
+func (w *levelWatcher) scan() []string {
+	files, err := fs.Glob(w.fsys, w.pattern)
+	if err != nil {
+		return nil
+	}
+
+	changed := make([]string, 0)
+	for _, file := range files {
+		info, err := fs.Stat(w.fsys, file)
+		if err != nil {
+			continue
+		}
+		last, seen := w.modTimes[file]
+		if (seen && !info.ModTime().Equal(last)) || (!seen && w.scanned) {
+			changed = append(changed, file)
+		}
+		w.modTimes[file] = info.ModTime()
+	}
+	w.scanned = true
+	return changed
+}
+
+// poll is called every tick and only touches the disk every levelPollInterval ticks
+func (w *levelWatcher) poll() []string {
+	w.ticks++
+	if w.ticks < levelPollInterval {
+		return nil
+	}
+	w.ticks = 0
+	return w.scan()
+}
+
+// reloadLevel re-parses a single level file and swaps it into the loaded level list.
+// Parse errors (and any panic raised while rebuilding the level) are returned rather than crashing the game.
+func (g *GameLogic) reloadLevel(filename string) (index int, levelData *LevelData, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: %v", filename, r)
+		}
+	}()
+
+	roadController, levelData, err := g.loadLevel(filename)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	for i, existing := range g.levelFiles {
+		if existing == filename {
+			g.levels[i] = roadController
+			g.levelData[i] = levelData
+			return i, levelData, nil
+		}
+	}
+
+	// New level file dropped into the folder
+	g.levels = append(g.levels, roadController)
+	g.levelData = append(g.levelData, levelData)
+	g.levelFiles = append(g.levelFiles, filename)
+	return len(g.levelFiles) - 1, levelData, nil
+}
+
+// checkLevelReload re-runs loadLevel for changed files and patches the running gameplay screen in place
+func (g *Game) checkLevelReload() {
+	logic := g.gameLogic
+	if logic.levelWatcher == nil {
+		return
+	}
+
+	for _, file := range logic.levelWatcher.poll() {
+		index, levelData, err := logic.reloadLevel(file)
+		if err != nil {
+			log.Printf("Level reload failed: %v", err)
+			if logic.reloadErrs == nil {
+				logic.reloadErrs = make(map[string]error)
+			}
+			logic.reloadErrs[file] = err
+			logic.reloadErrTime = time.Now()
+			continue
+		}
+
+		log.Printf("Reloaded level %s", file)
+		delete(logic.reloadErrs, file)
+
+		if gameplay, ok := g.currentScreen.(*GameplayScreen); ok && gameplay.levelIndex == index {
+			gameplay.ReloadLevel(levelData)
+		}
+	}
+}
+
+// drawReloadError shows the level files that are still failing to reload as an overlay banner
+func (g *Game) drawReloadError(screen *ebiten.Image) {
+	logic := g.gameLogic
+	if len(logic.reloadErrs) == 0 || time.Since(logic.reloadErrTime) > reloadErrorDuration {
+		return
+	}
+
+	width := screen.Bounds().Dx()
+	bannerHeight := 60
+
+	if logic.reloadBanner == nil || logic.reloadBanner.Bounds().Dx() != width {
+		logic.reloadBanner = ebiten.NewImage(width, bannerHeight)
+		logic.reloadBanner.Fill(color.RGBA{150, 20, 20, 220})
+	}
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(0, float64(screen.Bounds().Dy()-bannerHeight)/2)
+	screen.DrawImage(logic.reloadBanner, op)
+
+	files := slices.Sorted(maps.Keys(logic.reloadErrs))
+	msg := fmt.Sprintf("LEVEL RELOAD FAILED\n%v", logic.reloadErrs[files[0]])
+	if len(files) > 1 {
+		msg += fmt.Sprintf(" (AND %d MORE)", len(files)-1)
+	}
+
+	face := text.NewGoXFace(bitmapfont.Face)
+	textOp := &text.DrawOptions{}
+	textOp.GeoM.Translate(20, float64(screen.Bounds().Dy()-bannerHeight)/2+10)
+	textOp.LineSpacing = 20
+	textOp.ColorScale.ScaleWithColor(color.White)
+	text.Draw(screen, msg, face, textOp)
+}
@@ -0,0 +1,44 @@
+package game
+
+import (
+	"github.com/golangdaddy/roadster/pkg/leaderboard"
+	"github.com/golangdaddy/roadster/pkg/ui"
+)
+
+// Game modes. Each mode keeps its own leaderboard table for every level.
+const (
+	ModeStandard  = "standard"   // Drive the level, scoring overtakes and near misses
+	ModeTimeTrial = "time_trial" // Race a ghost of the quickest run from the start of the level to the end
+	ModeTaxi      = "taxi"       // Drive customers between laybys in the company's cab until too tired to go on
+	ModeHGV       = "hgv"        // Drive the level in a haulier's lorry, taking the breaks the tachograph demands
+)
+
+// modeChoices lists the modes offered on the level select screen. With a suspended licence,
+// the modes that are driving for a living are locked.
+func modeChoices(suspended bool) []ui.ModeChoice {
+	modes := []ui.ModeChoice{
+		{ID: ModeStandard, Name: "STANDARD"},
+		{ID: ModeTimeTrial, Name: "TIME TRIAL"},
+		{ID: ModeTaxi, Name: "TAXI"},
+		{ID: ModeHGV, Name: "HGV"},
+	}
+	for i, mode := range modes {
+		if suspended && requiresLicence(mode.ID) {
+			modes[i].Locked = "LICENCE SUSPENDED - TAKE THE DRIVER COURSE IN THE GARAGE"
+		}
+	}
+	return modes
+}
+
+// requiresLicence reports whether a mode cannot be driven with a suspended licence
+func requiresLicence(mode string) bool {
+	return mode == ModeTaxi || mode == ModeHGV
+}
+
+// modeOrder is how a mode's leaderboard tables are ranked
+func modeOrder(mode string) leaderboard.Order {
+	if mode == ModeTimeTrial {
+		return leaderboard.ByTime
+	}
+	return leaderboard.ByScore
+}
@@ -0,0 +1,241 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Need thresholds. Each effect is warned about a little before it starts.
+const (
+	lowFuelWarning  = 0.15 // Fraction of the tank left when the low fuel warning shows
+	hungryWarning   = 30.0 // Food level for the hunger warning
+	hungryLevel     = 20.0 // Food level below which steering lags
+	tiredWarning    = 30.0 // Sleep level for the tiredness warning
+	tiredLevel      = 20.0 // Sleep level below which the car drifts
+	microsleepLevel = 10.0 // Sleep level below which the player nods off at the wheel
+	bladderWarning  = 80.0 // Toilet level for the bladder warning
+	bladderFull     = 100.0
+)
+
+// Need effect tuning
+const (
+	maxSteeringLagTicks = 20    // Steering delay when starving (a third of a second)
+	tiredDriftStrength  = 0.6   // Peak sideways drift, px/frame, with no sleep left
+	microsleepChance    = 0.004 // Chance per tick of nodding off with no sleep left
+	microsleepTicks     = 45    // How long a microsleep lasts
+	microsleepSteer     = 0.25  // Steering the car wanders with while the player's eyes are shut
+	roadsideReliefRate  = 1.0   // Toilet points relieved per tick when stopped on the roadside
+	jerryCanWaitTicks   = 600   // How long fetching a jerry can takes (10 seconds)
+)
+
+// Warning flags, so each warning is shown once per time the need runs low
+const (
+	warnFuel = 1 << iota
+	warnFood
+	warnSleep
+	warnBladder
+)
+
+// updateNeeds applies the consequences of the player's needs running out, after they have drained this tick
+func (gs *GameplayScreen) updateNeeds() {
+	selectedCar := gs.playerCar.SelectedCar
+
+	gs.needWarning(warnFuel, selectedCar.FuelFraction() < lowFuelWarning, "LOW FUEL - FIND A PETROL STATION")
+	gs.needWarning(warnFood, gs.FoodLevel < hungryWarning, "HUNGRY - STOP FOR FOOD")
+	gs.needWarning(warnSleep, gs.SleepLevel < tiredWarning, "TIRED - FIND A BED")
+	gs.needWarning(warnBladder, gs.ToiletLevel >= bladderWarning, "NEED THE TOILET - STOP SOON")
+
+	// Out of fuel: broken down until the tank has something in it again
+	if selectedCar.FuelLevel <= 0 && !gs.brokenDown {
+		gs.brokenDown = true
+		gs.showToast("OUT OF FUEL - BROKEN DOWN")
+	} else if selectedCar.FuelLevel > 0 && gs.brokenDown {
+		gs.brokenDown = false
+		gs.jerryCanReadyAt = 0
+	}
+	if gs.brokenDown {
+		gs.updateBreakdown()
+	}
+
+	// Full bladder: pull over and go by the roadside
+	if gs.ToiletLevel >= bladderFull && !gs.pullingOver {
+		gs.pullingOver = true
+		gs.showToast("BLADDER FULL - PULLING OVER")
+	}
+	if gs.pullingOver && math.Abs(gs.playerCar.VelocityY) < 0.5 {
+		gs.ToiletLevel = max(0, gs.ToiletLevel-roadsideReliefRate)
+		if gs.ToiletLevel == 0 {
+			gs.pullingOver = false
+			gs.showToast("RELIEVED")
+		}
+	}
+	if gs.forcedStop() {
+		gs.autoDrive = false
+	}
+
+	// Exhaustion: nod off at random
+	if gs.SleepLevel < microsleepLevel && gs.ticks >= gs.microsleepUntil {
+		chance := microsleepChance * (1 - gs.SleepLevel/microsleepLevel)
+		if gs.playerCar.VelocityY > 0.5 && gs.rng.Float64() < chance {
+			gs.microsleepUntil = gs.ticks + microsleepTicks
+			gs.microsleepSteer = microsleepSteer
+			if gs.rng.Intn(2) == 0 {
+				gs.microsleepSteer = -microsleepSteer
+			}
+			gs.showToast("MICROSLEEP!")
+		}
+	}
+}
+
+// needWarning shows message the first time low becomes true, and re-arms once it is false again
+func (gs *GameplayScreen) needWarning(flag int, low bool, message string) {
+	if !low {
+		gs.needWarnings &^= flag
+		return
+	}
+	if gs.needWarnings&flag == 0 {
+		gs.needWarnings |= flag
+		gs.showToast("%s", message)
+	}
+}
+
+// forcedStop reports whether the player has lost control of the throttle and the car is being brought to a halt
+func (gs *GameplayScreen) forcedStop() bool {
+	return gs.brokenDown || gs.pullingOver || gs.policeStop
+}
+
+// applyForcedStop brakes the car while it is broken down, pulling over or stopped by the police
+func (gs *GameplayScreen) applyForcedStop() {
+	if gs.playerCar.VelocityY > 0 {
+		gs.playerCar.VelocityY = max(0, gs.playerCar.VelocityY-gs.playerCar.Acceleration)
+	}
+}
+
+// steeringInput returns this tick's steering, -1 (left) to 1 (right), after hunger lag and microsleeps
+func (gs *GameplayScreen) steeringInput() float64 {
+	input := 0.0
+	if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
+		input = -1
+	} else if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
+		input = 1
+	}
+
+	// Hunger slows reactions: the car answers the keys from a few ticks ago
+	gs.steeringHistory = append(gs.steeringHistory, input)
+	lag := 0
+	if gs.FoodLevel < hungryLevel {
+		lag = int(maxSteeringLagTicks * (1 - gs.FoodLevel/hungryLevel))
+	}
+	if len(gs.steeringHistory) > maxSteeringLagTicks+1 {
+		gs.steeringHistory = gs.steeringHistory[len(gs.steeringHistory)-maxSteeringLagTicks-1:]
+	}
+	if lag >= len(gs.steeringHistory) {
+		lag = len(gs.steeringHistory) - 1
+	}
+	input = gs.steeringHistory[len(gs.steeringHistory)-1-lag]
+
+	// Eyes shut: the keys do nothing and the car wanders
+	if gs.ticks < gs.microsleepUntil {
+		return gs.microsleepSteer
+	}
+	return input
+}
+
+// tiredDrift is the sideways wander added to the car when the player is short of sleep
+func (gs *GameplayScreen) tiredDrift() float64 {
+	if gs.SleepLevel >= tiredLevel || gs.playerCar.VelocityY < 0.5 {
+		return 0
+	}
+	return math.Sin(float64(gs.ticks)/90) * tiredDriftStrength * (1 - gs.SleepLevel/tiredLevel)
+}
+
+// updateBreakdown handles the player's choice of help once the car has rolled to a stop with an empty tank
+func (gs *GameplayScreen) updateBreakdown() {
+	if gs.jerryCanReadyAt > 0 {
+		if gs.ticks >= gs.jerryCanReadyAt {
+			gs.jerryCanReadyAt = 0
+			gs.playerCar.SelectedCar.AddFuel(economy.JerryCanLitres)
+			gs.showToast("JERRY CAN: +%.0f L", economy.JerryCanLitres)
+		}
+		return
+	}
+	if math.Abs(gs.playerCar.VelocityY) >= 0.5 || gs.onFoot {
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyJ) {
+		if !gs.wallet.Charge(economy.JerryCanPrice) {
+			gs.notEnoughMoney()
+			return
+		}
+		gs.jerryCanReadyAt = gs.ticks + jerryCanWaitTicks
+		gs.showToast("WALKING TO FETCH A JERRY CAN...")
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		gs.callRecoveryTruck()
+	}
+}
+
+// drawNeeds draws the effects of the player's needs: eyes closing in a microsleep
+func (gs *GameplayScreen) drawNeeds(screen *ebiten.Image) {
+	if gs.ticks < gs.microsleepUntil {
+		// Eyelids close fast and open slowly
+		remaining := float64(gs.microsleepUntil-gs.ticks) / microsleepTicks
+		alpha := uint8(230 * min(1, remaining*2))
+		eyelids := ebiten.NewImage(gs.screenWidth, gs.screenHeight)
+		eyelids.Fill(color.RGBA{0, 0, 0, alpha})
+		screen.DrawImage(eyelids, nil)
+	}
+}
+
+// breakdownHint returns the options for a car that has run out of fuel, or "" if it hasn't
+func (gs *GameplayScreen) breakdownHint() string {
+	if !gs.brokenDown {
+		return ""
+	}
+	if gs.jerryCanReadyAt > 0 {
+		return fmt.Sprintf("FETCHING A JERRY CAN... %ds", (gs.jerryCanReadyAt-gs.ticks)/60+1)
+	}
+	return fmt.Sprintf("OUT OF FUEL - J: JERRY CAN $%.0f | R: RECOVERY TRUCK $%.0f", economy.JerryCanPrice, economy.RecoveryTruckPrice)
+}
+
+// callRecoveryTruck tows the car to the nearest petrol station ahead (or behind, if there is none ahead).
+// The tow cannot be refused, so it takes whatever money is left if the player cannot cover it.
+// With no stations on the level the driver leaves a jerry can's worth of fuel instead.
+func (gs *GameplayScreen) callRecoveryTruck() {
+	paid := gs.wallet.ChargeUpTo(economy.RecoveryTruckPrice)
+	gs.showToast("RECOVERY TRUCK: $%.2f", paid)
+
+	best := -1
+	for i, station := range gs.petrolStations {
+		if station.ServiceType != road.ServiceTypePetrol {
+			continue
+		}
+		if best < 0 || towPreferred(station, gs.petrolStations[best], gs.playerCar.Y) {
+			best = i
+		}
+	}
+	if best < 0 {
+		gs.playerCar.SelectedCar.AddFuel(economy.JerryCanLitres)
+		return
+	}
+
+	station := gs.petrolStations[best]
+	gs.moveToRespawn(station.X+40, station.Y)
+}
+
+// towPreferred reports whether station a is a better tow destination than b for a car at y:
+// the nearest station ahead, else the nearest behind
+func towPreferred(a, b PetrolStation, y float64) bool {
+	aAhead, bAhead := a.Y <= y, b.Y <= y
+	if aAhead != bAhead {
+		return aAhead
+	}
+	return math.Abs(a.Y-y) < math.Abs(b.Y-y)
+}
@@ -0,0 +1,251 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/data"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Hitchhikers wait at laybys with an exit further up the level
+const (
+	hitchhikerChance = 0.6  // Share of those laybys with someone thumbing a lift
+	pickupRange      = 50.0 // px from the player on foot
+	baseFare         = 40.0
+	farePerMile      = 60.0
+)
+
+// Passenger mood, 0 (furious) to 100 (delighted)
+const (
+	startMood         = 70.0
+	speedingMoodLoss  = 0.05 // Per tick spent over the limit (3 a second)
+	calmMoodGain      = 0.01 // Per tick spent at or under it
+	crashMoodLoss     = 25.0
+	tipMood           = 80.0 // Passengers this happy tip
+	tipShare          = 0.2  // Tip, as a share of the fare
+	speedingMarginMPH = 5.0  // MPH over the limit a passenger puts up with
+)
+
+// Hitchhiker is someone thumbing a lift at a layby, or riding as a passenger once picked up
+type Hitchhiker struct {
+	ID          string  `json:"id"` // Level and layby they were waiting at
+	Name        string  `json:"name"`
+	CharacterID string  `json:"character_id"` // Headshot character
+	Destination string  `json:"destination"`  // Exit they want to be dropped at
+	Fare        float64 `json:"fare"`         // Paid on arrival, scaled by mood
+	X           float64 `json:"x"`            // Where they wait
+	Y           float64 `json:"y"`
+	Mood        float64 `json:"mood"`
+}
+
+// spawnHitchhikers puts hitchhikers at the laybys. The same level always has the same hitchhikers,
+// apart from any already riding with the player.
+func (gs *GameplayScreen) spawnHitchhikers() {
+	gs.hitchhikers = nil
+	laneWidth := 80.0
+
+	for _, layby := range gs.levelData.Checkpoints {
+		if layby >= len(gs.roadSegments) {
+			continue
+		}
+		id := fmt.Sprintf("%s/%d", gs.levelData.Name, layby)
+		if gs.hasPassenger(id) {
+			continue
+		}
+
+		h := fnv.New64a()
+		h.Write([]byte(id))
+		rng := rand.New(rand.NewSource(int64(h.Sum64())))
+		if rng.Float64() >= hitchhikerChance {
+			continue
+		}
+
+		segment := gs.roadSegments[layby]
+		var exits []LevelExit
+		for _, exit := range gs.levelData.Exits {
+			if exit.Segment > layby && exit.Segment < len(gs.roadSegments) {
+				exits = append(exits, exit)
+			}
+		}
+		if len(exits) == 0 {
+			continue
+		}
+		exit := exits[rng.Intn(len(exits))]
+		miles := (segment.Y - gs.roadSegments[exit.Segment].Y) * milesPerPixel
+
+		names, character := data.CommonNames.Male, "man"
+		if rng.Float64() < 0.5 {
+			names, character = data.CommonNames.Female, "woman"
+		}
+
+		// Standing on the verge beside the layby lane
+		leftEdge := -float64(segment.StartLaneIndex) * laneWidth
+		gs.hitchhikers = append(gs.hitchhikers, Hitchhiker{
+			ID:          id,
+			Name:        names[rng.Intn(len(names))],
+			CharacterID: fmt.Sprintf("%s%d", character, rng.Intn(4)+1),
+			Destination: exit.Destination,
+			Fare:        math.Round(baseFare + farePerMile*miles),
+			X:           leftEdge - 20,
+			Y:           segment.Y - 300,
+			Mood:        startMood,
+		})
+	}
+}
+
+// hasPassenger reports whether the hitchhiker with this ID is riding with the player
+func (gs *GameplayScreen) hasPassenger(id string) bool {
+	for _, p := range gs.passengers {
+		if p.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// pickUpHitchhiker takes on the hitchhiker the player on foot is standing next to, if there is a free seat.
+// It reports whether there was anyone to pick up.
+func (gs *GameplayScreen) pickUpHitchhiker() bool {
+	for i, h := range gs.hitchhikers {
+		if math.Hypot(h.X-gs.playerPed.X, h.Y-gs.playerPed.Y) >= pickupRange {
+			continue
+		}
+
+		if len(gs.passengers) >= gs.playerCar.SelectedCar.PassengerSeats() {
+			gs.showToast("NO FREE SEATS FOR %s", h.Name)
+			return true
+		}
+		gs.hitchhikers = append(gs.hitchhikers[:i], gs.hitchhikers[i+1:]...)
+		gs.passengers = append(gs.passengers, h)
+		gs.showToast("%s GOT IN - WANTS THE EXIT TO %s", h.Name, exitName(h.Destination))
+		return true
+	}
+	return false
+}
+
+// updatePassengerMood sours the passengers' mood while the car is speeding and lets it recover otherwise.
+// A passenger whose mood runs out gets out without paying.
+func (gs *GameplayScreen) updatePassengerMood(speedMPH, limitMPH float64) {
+	change := calmMoodGain
+	if speedMPH > limitMPH+speedingMarginMPH {
+		change = -speedingMoodLoss
+	}
+	gs.changePassengerMood(change)
+}
+
+// upsetPassengers knocks the passengers' mood after a crash
+func (gs *GameplayScreen) upsetPassengers() {
+	gs.changePassengerMood(-crashMoodLoss)
+}
+
+// changePassengerMood adds change to every passenger's mood, letting out any who have had enough
+func (gs *GameplayScreen) changePassengerMood(change float64) {
+	kept := gs.passengers[:0]
+	for _, p := range gs.passengers {
+		p.Mood = max(0, min(100, p.Mood+change))
+		if p.Mood <= 0 {
+			gs.showToast("%s HAD ENOUGH AND GOT OUT", p.Name)
+			continue
+		}
+		kept = append(kept, p)
+	}
+	gs.passengers = kept
+}
+
+// dropOffPassengers lets out and takes the fare from every passenger bound for the exit the player has taken.
+// Unhappy passengers pay less; happy ones tip.
+func (gs *GameplayScreen) dropOffPassengers(destination string) {
+	kept := gs.passengers[:0]
+	for _, p := range gs.passengers {
+		if p.Destination != destination {
+			kept = append(kept, p)
+			continue
+		}
+
+		paid := p.Fare * (0.5 + p.Mood/200)
+		if p.Mood >= tipMood {
+			paid += p.Fare * tipShare
+		}
+		gs.wallet.Earn(paid)
+		gs.showToast("%s PAID $%.2f", p.Name, paid)
+	}
+	gs.passengers = kept
+}
+
+// drawHitchhikers draws the hitchhikers waiting at the laybys, with their name and where they want to go
+func (gs *GameplayScreen) drawHitchhikers(screen *ebiten.Image) {
+	if len(gs.hitchhikers) == 0 {
+		return
+	}
+	if gs.hitchhikerSprite == nil {
+		gs.hitchhikerSprite = createHitchhikerSprite()
+	}
+	face := text.NewGoXFace(bitmapfont.Face)
+
+	for _, h := range gs.hitchhikers {
+		screenX := h.X - gs.cameraX - 8
+		screenY := h.Y - gs.cameraY - 8
+		if screenY < -50 || screenY > float64(gs.screenHeight)+50 {
+			continue
+		}
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(screenX, screenY)
+		screen.DrawImage(gs.hitchhikerSprite, op)
+
+		label := h.Name + " -> " + exitName(h.Destination)
+		labelOp := &text.DrawOptions{}
+		labelOp.GeoM.Translate(screenX+8-text.Advance(label, face)/2, screenY-20)
+		labelOp.ColorScale.ScaleWithColor(color.White)
+		text.Draw(screen, label, face, labelOp)
+	}
+}
+
+// drawPassengers lists the passengers under the contracts, with their faces and mood
+func (gs *GameplayScreen) drawPassengers(screen *ebiten.Image) {
+	face := text.NewGoXFace(bitmapfont.Face)
+	x := 20.0
+	y := 195.0 + float64(len(gs.activeContracts))*20 + 10
+
+	for i, p := range gs.passengers {
+		rowY := y + float64(i)*24
+		if headshot, err := assets.Default.Headshot(p.CharacterID); err == nil {
+			scale := 20 / float64(headshot.Bounds().Dx())
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(scale, scale)
+			op.GeoM.Translate(x, rowY-4)
+			screen.DrawImage(headshot, op)
+		}
+
+		clr := color.RGBA{100, 255, 100, 255}
+		switch {
+		case p.Mood < 30:
+			clr = color.RGBA{255, 80, 80, 255}
+		case p.Mood < tipMood:
+			clr = color.RGBA{255, 215, 0, 255}
+		}
+		op := &text.DrawOptions{}
+		op.GeoM.Translate(x+26, rowY)
+		op.ColorScale.ScaleWithColor(clr)
+		text.Draw(screen, fmt.Sprintf("%s -> %s  MOOD %.0f%%", p.Name, exitName(p.Destination), p.Mood), face, op)
+	}
+}
+
+// createHitchhikerSprite generates a pedestrian sprite in a high-visibility shirt
+func createHitchhikerSprite() *ebiten.Image {
+	img := ebiten.NewImage(16, 16)
+	img.Fill(color.RGBA{255, 200, 150, 255}) // Skin
+	for y := 6; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			img.Set(x, y, color.RGBA{255, 140, 0, 255})
+		}
+	}
+	return img
+}
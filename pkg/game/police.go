@@ -0,0 +1,300 @@
+package game
+
+import (
+	"image/color"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/licence"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Police patrol among the traffic and pull over speeders they see; speed cameras catch the rest
+const (
+	policeChance         = 0.04  // Share of spawned traffic that is a patrol car
+	policeSightRange     = 350.0 // How far along the road (px) a patrol car can clock the player
+	policePullOverRange  = 160.0 // How close (px) a pursuing patrol car gets before the player must pull over
+	policeFollowDistance = 120.0 // How far behind the player (px) a pursuing patrol car sits
+	policeClosingMPH     = 30.0  // How much faster than the player a pursuing patrol car drives to catch up
+	cameraFlashTicks     = 12
+)
+
+// policeColor is the body colour of a patrol car; drawPoliceLivery adds the rest
+var policeColor = color.RGBA{235, 235, 235, 255}
+
+// policeEnforcing reports whether the police are out on this run. Time trials are left to the clock:
+// patrols draw on the traffic RNG, so a single pursuit would change the traffic for the rest of the attempt.
+func (gs *GameplayScreen) policeEnforcing() bool {
+	return gs.mode != ModeTimeTrial
+}
+
+// currentSpeedLimit returns the speed limit (MPH) of the lane the player is in
+func (gs *GameplayScreen) currentSpeedLimit() float64 {
+	currentSegment, _ := gs.getCurrentRoadSegment()
+	return 50.0 + float64(gs.getCurrentLane(currentSegment, 80.0))*10.0
+}
+
+// pursue replaces the traffic AI for a patrol car chasing the player: it works its way into the player's lane
+// and closes to just behind them, matching their speed once there. A wanted player is chased harder
+// (see chase).
+func (tc *TrafficCar) pursue(gs *GameplayScreen) {
+	if gs.wanted.Wanted() {
+		tc.chase(gs)
+		return
+	}
+
+	tc.steerTowards(gs, tc.playerLane(gs))
+
+	tc.TargetSpeed = gs.playerCar.VelocityY
+	if gap := tc.Y - gs.playerCar.Y; gap > policeFollowDistance {
+		tc.TargetSpeed += policeClosingMPH / MPHPerPixelPerFrame
+	} else if gap < policeFollowDistance/2 {
+		tc.TargetSpeed *= 0.9
+	}
+	tc.driveAtTargetSpeed()
+}
+
+// playerLane returns the lane of the patrol car's segment the player is in. Traffic never uses lane 0,
+// so a player in the layby is followed from the lane beside it.
+func (tc *TrafficCar) playerLane(gs *GameplayScreen) int {
+	laneWidth := 80.0
+	tcSegment := gs.getSegmentAt(tc.Y)
+	segLeftEdge := -float64(tcSegment.StartLaneIndex) * laneWidth
+	return max(1, min(tcSegment.LaneCount-1, int((gs.playerCar.X-segLeftEdge)/laneWidth)))
+}
+
+// steerTowards starts a change of one lane towards lane, unless a change is already under way
+func (tc *TrafficCar) steerTowards(gs *GameplayScreen, lane int) {
+	if tc.TargetLane != 0 || lane == tc.Lane || gs.getSegmentAt(tc.Y).LaneCount < 2 {
+		return
+	}
+	tc.TargetLane = tc.Lane + 1
+	if lane < tc.Lane {
+		tc.TargetLane = tc.Lane - 1
+	}
+	tc.LaneProgress = 0.01
+	tc.LastLaneChangeTime = gs.nowMillis()
+}
+
+// driveAtTargetSpeed accelerates or brakes a patrol car towards TargetSpeed, harder than ordinary traffic
+func (tc *TrafficCar) driveAtTargetSpeed() {
+	if tc.VelocityY < tc.TargetSpeed {
+		tc.VelocityY = min(tc.TargetSpeed, tc.VelocityY+tc.Acceleration*2)
+	} else {
+		tc.VelocityY = max(tc.TargetSpeed, tc.VelocityY-tc.Deceleration*2)
+	}
+	tc.VelocityY = max(0, tc.VelocityY)
+}
+
+// updatePolice has patrol cars clock the player and give chase, and tickets the player once they have pulled over
+func (gs *GameplayScreen) updatePolice() {
+	if !gs.policeEnforcing() {
+		return
+	}
+	if gs.wanted.Wanted() {
+		gs.updateChase()
+		return
+	}
+
+	gs.trafficMutex.Lock()
+	defer gs.trafficMutex.Unlock()
+
+	if gs.pursuer != nil && !slices.Contains(gs.traffic, gs.pursuer) {
+		gs.pursuer = nil
+		gs.policeStop = false
+		gs.showToast("YOU LOST THE POLICE")
+	}
+
+	if gs.pursuer == nil {
+		gs.spotSpeeder()
+		return
+	}
+
+	p := gs.pursuer
+	if !gs.policeStop && math.Abs(p.Y-gs.playerCar.Y) < policePullOverRange && math.Abs(p.X-gs.playerCar.X) < policePullOverRange {
+		gs.policeStop = true
+		gs.showToast("POLICE: PULL OVER NOW")
+	}
+	if gs.policeStop && math.Abs(gs.playerCar.VelocityY) < 0.5 {
+		gs.issueTicket("POLICE", licence.KindPolice, p.ClockedMPH, p.ClockedLimitMPH)
+		p.Pursuing = false
+		gs.pursuer = nil
+		gs.policeStop = false
+	}
+}
+
+// spotSpeeder starts a pursuit if a patrol car within sight clocks the player speeding.
+// The caller holds trafficMutex.
+func (gs *GameplayScreen) spotSpeeder() {
+	if gs.onFoot {
+		return
+	}
+	speedMPH := gs.playerCar.VelocityY * MPHPerPixelPerFrame
+	limitMPH := gs.currentSpeedLimit()
+	if !licence.Speeding(speedMPH, limitMPH) {
+		return
+	}
+
+	for _, tc := range gs.traffic {
+		if !tc.Police || math.Abs(tc.Y-gs.playerCar.Y) > policeSightRange {
+			continue
+		}
+		tc.Pursuing = true
+		tc.ClockedMPH = speedMPH
+		tc.ClockedLimitMPH = limitMPH
+		gs.pursuer = tc
+		gs.showToast("POLICE! CLOCKED AT %.0f IN A %.0f", speedMPH, limitMPH)
+		return
+	}
+}
+
+// checkSpeedCamera reads the player's speed once as they enter a segment with a speed camera
+func (gs *GameplayScreen) checkSpeedCamera(segmentIdx int) {
+	if segmentIdx == gs.cameraSegment || gs.onFoot || !gs.policeEnforcing() || !slices.Contains(gs.levelData.SpeedCameras, segmentIdx) {
+		return
+	}
+	gs.cameraSegment = segmentIdx
+
+	speedMPH := gs.playerCar.VelocityY * MPHPerPixelPerFrame
+	limitMPH := gs.currentSpeedLimit()
+	if !licence.Speeding(speedMPH, limitMPH) {
+		return
+	}
+	gs.cameraFlashUntil = gs.ticks + cameraFlashTicks
+	gs.issueTicket("SPEED CAMERA", licence.KindSpeedCamera, speedMPH, limitMPH)
+}
+
+// issueTicket puts a speeding offence on the player's licence and takes the fine, or as much of it as they have
+func (gs *GameplayScreen) issueTicket(by, kind string, speedMPH, limitMPH float64) {
+	points, fine := licence.SpeedingPenalty(speedMPH, limitMPH)
+	paid := gs.wallet.ChargeUpTo(fine)
+	gs.offences++
+	gs.penaltyPoints += points
+	gs.offenceFines += paid
+
+	suspended := gs.licence.Record(licence.Offence{
+		Kind:     kind,
+		Level:    gs.levelData.Name,
+		SpeedMPH: math.Round(speedMPH),
+		LimitMPH: limitMPH,
+		Points:   points,
+		Fine:     paid,
+		Date:     time.Now(),
+	})
+	gs.showToast("%s: %.0f IN A %.0f - %d POINTS, $%.0f FINE", by, speedMPH, limitMPH, points, paid)
+	if suspended {
+		gs.showToast("LICENCE SUSPENDED - %d POINTS", gs.licence.Points)
+	}
+}
+
+// policeResults fills in the run's tickets and where they have left the licence
+func (gs *GameplayScreen) policeResults(r *ui.RunResults) {
+	r.Offences = gs.offences
+	r.PenaltyPoints = gs.penaltyPoints
+	r.OffenceFines = gs.offenceFines
+	r.LicencePoints = gs.licence.Points
+	r.LicenceSuspended = gs.licence.Suspended
+}
+
+// drawSpeedCameras draws a gantry across the road, with a camera over every lane, at each speed camera segment
+func (gs *GameplayScreen) drawSpeedCameras(screen *ebiten.Image) {
+	laneWidth := 80.0
+	for _, idx := range gs.levelData.SpeedCameras {
+		if idx >= len(gs.roadSegments) {
+			continue
+		}
+		segment := gs.roadSegments[idx]
+		screenY := segment.Y - gs.cameraY
+		if screenY < -50 || screenY > float64(gs.screenHeight)+50 {
+			continue
+		}
+
+		leftEdge := -float64(segment.StartLaneIndex)*laneWidth - gs.cameraX
+		width := float64(segment.LaneCount) * laneWidth
+
+		gantry := ebiten.NewImage(int(width)+40, 6)
+		gantry.Fill(color.RGBA{110, 110, 110, 255})
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(leftEdge-20, screenY-3)
+		screen.DrawImage(gantry, op)
+
+		camera := ebiten.NewImage(12, 16)
+		camera.Fill(color.RGBA{255, 200, 0, 255})
+		for lane := 0; lane < segment.LaneCount; lane++ {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(leftEdge+float64(lane)*laneWidth+laneWidth/2-6, screenY-8)
+			screen.DrawImage(camera, op)
+		}
+	}
+}
+
+// drawPolice flashes the screen for a speed camera and shows a banner while the police are after the player
+func (gs *GameplayScreen) drawPolice(screen *ebiten.Image) {
+	if left := gs.cameraFlashUntil - gs.ticks; left > 0 {
+		flash := ebiten.NewImage(gs.screenWidth, gs.screenHeight)
+		flash.Fill(color.RGBA{255, 255, 255, 255})
+		op := &ebiten.DrawImageOptions{}
+		op.ColorScale.ScaleAlpha(float32(left) / cameraFlashTicks * 0.6)
+		screen.DrawImage(flash, op)
+	}
+
+	if gs.pursuer == nil {
+		return
+	}
+	label := "POLICE PURSUIT"
+	if gs.policeStop {
+		label = "POLICE - PULL OVER"
+	}
+	clr := color.RGBA{255, 60, 60, 255}
+	if gs.ticks/15%2 == 0 {
+		clr = color.RGBA{80, 140, 255, 255}
+	}
+
+	face := text.NewGoXFace(bitmapfont.Face)
+	scale := 1.5
+	op := &text.DrawOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(float64(gs.screenWidth)/2-text.Advance(label, face)*scale/2, 95)
+	op.ColorScale.ScaleWithColor(clr)
+	text.Draw(screen, label, face, op)
+}
+
+// drawPoliceLivery paints a traffic car sprite as a patrol car: battenburg side panels and a light bar
+// that flashes while it is in pursuit
+func drawPoliceLivery(carImg *ebiten.Image, pursuing bool, ticks int64) {
+	yellow := color.RGBA{255, 220, 0, 255}
+	blue := color.RGBA{20, 60, 200, 255}
+	for y := 36; y < 52; y++ {
+		for x := 6; x < 34; x++ {
+			if x >= 9 && x < 31 {
+				continue
+			}
+			clr := yellow
+			if (x/3+y/4)%2 == 0 {
+				clr = blue
+			}
+			carImg.Set(x, y, clr)
+		}
+	}
+
+	left, right := color.RGBA{0, 0, 150, 255}, color.RGBA{0, 0, 150, 255}
+	if pursuing {
+		if ticks/8%2 == 0 {
+			left = color.RGBA{60, 120, 255, 255}
+		} else {
+			right = color.RGBA{60, 120, 255, 255}
+		}
+	}
+	for y := 30; y < 34; y++ {
+		for x := 10; x < 20; x++ {
+			carImg.Set(x, y, left)
+		}
+		for x := 20; x < 30; x++ {
+			carImg.Set(x, y, right)
+		}
+	}
+}
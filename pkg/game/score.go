@@ -0,0 +1,115 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// Near misses: passing this close to a car's collision box without touching it
+const (
+	nearMissMargin = 15.0 // px around the traffic car's collision box
+	nearMissMinMPH = 30.0 // Slower than this, a close pass is just queueing
+)
+
+// popupTicks is how long a score popup floats above the car (1 second)
+const popupTicks = 60
+
+// scorePopup is points shown rising from the player's car
+type scorePopup struct {
+	message string
+	expires int64
+}
+
+// updateNearMisses marks the traffic the player is squeezing past this tick. The points are scored
+// when the car is overtaken, so a close pass that ends in a crash scores nothing.
+func (gs *GameplayScreen) updateNearMisses() {
+	if gs.playerCar.VelocityY*MPHPerPixelPerFrame < nearMissMinMPH {
+		return
+	}
+
+	gs.trafficMutex.Lock()
+	defer gs.trafficMutex.Unlock()
+
+	for _, tc := range gs.traffic {
+		if tc.Passed || tc.Touched || tc.NearMiss {
+			continue
+		}
+		if gs.overlapsPlayer(tc, nearMissMargin) && !gs.overlapsPlayer(tc, 0) {
+			tc.NearMiss = true
+		}
+	}
+}
+
+// scoreOvertake scores passing tc, with the near-miss bonus if the player squeezed past it cleanly
+func (gs *GameplayScreen) scoreOvertake(tc *TrafficCar) {
+	points := gs.score.Overtake()
+	if !tc.NearMiss || tc.Touched {
+		gs.showPopup("+%d", points)
+		return
+	}
+
+	points += gs.score.NearMiss()
+	gs.awardXP(progression.XPPerNearMiss)
+	gs.showPopup("NEAR MISS +%d", points)
+}
+
+// scoreCrash ends the combo and the speed multiplier, and stops hit scoring as a near miss
+func (gs *GameplayScreen) scoreCrash(hit *TrafficCar) {
+	gs.score.Crash()
+	hit.Touched = true
+}
+
+// showPopup floats a score message above the player's car
+func (gs *GameplayScreen) showPopup(format string, args ...any) {
+	gs.popups = append(gs.popups, scorePopup{
+		message: fmt.Sprintf(format, args...),
+		expires: gs.ticks + popupTicks,
+	})
+}
+
+// drawScore draws the score, combo and multiplier at the top of the screen, and the popups rising from the car
+func (gs *GameplayScreen) drawScore(screen *ebiten.Image) {
+	face := text.NewGoXFace(bitmapfont.Face)
+	centerX := float64(gs.screenWidth) / 2
+
+	scoreText := fmt.Sprintf("SCORE %d", gs.score.Score)
+	scale := 2.0
+	op := &text.DrawOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(centerX-text.Advance(scoreText, face)*scale/2, 15)
+	op.ColorScale.ScaleWithColor(color.RGBA{255, 255, 255, 255})
+	text.Draw(screen, scoreText, face, op)
+
+	comboText := fmt.Sprintf("COMBO %d  x%.1f", gs.score.Combo, gs.score.Multiplier)
+	comboOp := &text.DrawOptions{}
+	comboOp.GeoM.Translate(centerX-text.Advance(comboText, face)/2, 50)
+	comboOp.ColorScale.ScaleWithColor(color.RGBA{255, 215, 0, 255})
+	text.Draw(screen, comboText, face, comboOp)
+
+	live := gs.popups[:0]
+	for _, p := range gs.popups {
+		if gs.ticks < p.expires {
+			live = append(live, p)
+		}
+	}
+	gs.popups = live
+
+	for i, p := range gs.popups {
+		remaining := float64(p.expires-gs.ticks) / popupTicks
+		rise := (1 - remaining) * 40
+		x := gs.playerCar.X - gs.cameraX - text.Advance(p.message, face)*1.5/2
+		y := gs.playerCar.Y - gs.cameraY - 60 - rise - float64(len(gs.popups)-1-i)*24
+
+		popupOp := &text.DrawOptions{}
+		popupOp.GeoM.Scale(1.5, 1.5)
+		popupOp.GeoM.Translate(x, y)
+		popupOp.ColorScale.ScaleWithColor(color.RGBA{255, 255, 120, 255})
+		popupOp.ColorScale.ScaleAlpha(float32(min(1, remaining*2)))
+		text.Draw(screen, p.message, face, popupOp)
+	}
+}
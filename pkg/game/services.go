@@ -0,0 +1,145 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/road"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// serviceRate is how many points of a need meter a stopped player takes on per tick at a food stop or bed
+const serviceRate = 0.5
+
+// label is the sign shown above a station
+func (s PetrolStation) label() string {
+	switch s.ServiceType {
+	case road.ServiceTypePetrol:
+		return fmt.Sprintf("FUEL $%.2f/L", s.FuelPrice)
+	case road.ServiceTypeFood:
+		return "FOOD"
+	case road.ServiceTypeShop:
+		return "SHOP"
+	case road.ServiceTypeRestroom:
+		return "WC"
+	case road.ServiceTypeHotel:
+		return "HOTEL"
+	case road.ServiceTypeMotel:
+		return "MOTEL"
+	case road.ServiceTypeRVPark:
+		return "RV PARK"
+	default:
+		return "CAMPING"
+	}
+}
+
+// useService serves the player at the station they are stopped at (index i). Petrol stations open the
+// pump panel once per stop, and only to a player in their car; food and beds are sold a tick's worth at a time.
+// Nothing is handed over that the wallet cannot pay for.
+func (gs *GameplayScreen) useService(i int, station PetrolStation) {
+	switch station.ServiceType {
+	case road.ServiceTypePetrol:
+		if gs.onFoot {
+			return
+		}
+		if !gs.pumpShown {
+			gs.pumpShown = true
+			gs.openPetrolStation(station)
+		}
+		gs.offerRepair()
+
+	case road.ServiceTypeFood, road.ServiceTypeShop:
+		if station.ServiceType == road.ServiceTypeShop {
+			gs.offerContracts(i, station)
+		}
+		points := min(serviceRate, gs.FoodCapacity-gs.FoodLevel)
+		if points <= 0 {
+			return
+		}
+		if !gs.wallet.Charge(points * economy.FoodPricePerPoint) {
+			gs.notEnoughMoney()
+			return
+		}
+		gs.FoodLevel += points
+		gs.rewardServiceStop()
+
+	default:
+		price := economy.SleepPricePerPoint(station.ServiceType)
+		points := min(serviceRate, gs.SleepCapacity-gs.SleepLevel)
+		if price == 0 || points <= 0 {
+			return
+		}
+		if !gs.wallet.Charge(points * price) {
+			gs.notEnoughMoney()
+			return
+		}
+		gs.SleepLevel += points
+		gs.rewardServiceStop()
+	}
+}
+
+// rewardServiceStop gives the service stop XP the first time the player buys something at a stop
+func (gs *GameplayScreen) rewardServiceStop() {
+	if gs.stopRewarded {
+		return
+	}
+	gs.stopRewarded = true
+	gs.awardXP(progression.XPPerServiceStop)
+}
+
+// openPetrolStation shows the pump panel for station over the frozen road
+func (gs *GameplayScreen) openPetrolStation(station PetrolStation) {
+	selectedCar := gs.playerCar.SelectedCar
+	onPurchase := func(litres, pricePerLitre float64) bool {
+		cost := litres * pricePerLitre
+		if !gs.wallet.Charge(cost) {
+			return false
+		}
+		selectedCar.AddFuel(litres)
+		gs.rewardServiceStop()
+		gs.showToast("BOUGHT %.1f L FOR $%.2f", litres, cost)
+		return true
+	}
+	onExit := func() {
+		gs.petrolScreen = nil
+	}
+
+	gs.petrolScreen = ui.NewPetrolStationScreen(selectedCar, station.FuelPrice, gs.wallet.Balance, onPurchase, onExit)
+}
+
+// offerRepair repairs the car when the player presses F while stopped at a petrol station
+func (gs *GameplayScreen) offerRepair() {
+	selectedCar := gs.playerCar.SelectedCar
+	if !inpututil.IsKeyJustPressed(ebiten.KeyF) || !selectedCar.NeedsRepair() {
+		return
+	}
+
+	bill := economy.RepairBill(selectedCar)
+	if !gs.wallet.Charge(bill) {
+		gs.notEnoughMoney()
+		return
+	}
+	selectedCar.Repair()
+	gs.applyCarStats()
+	gs.showToast("CAR REPAIRED: $%.2f", bill)
+}
+
+// canRepair reports whether the player is stopped at a petrol station with a car that needs work
+func (gs *GameplayScreen) canRepair() bool {
+	if gs.onFoot || gs.atStation < 0 || gs.atStation >= len(gs.petrolStations) {
+		return false
+	}
+	return gs.petrolStations[gs.atStation].ServiceType == road.ServiceTypePetrol && gs.playerCar.SelectedCar.NeedsRepair()
+}
+
+// notEnoughMoney tells the player a purchase was refused, at most once per toast
+func (gs *GameplayScreen) notEnoughMoney() {
+	if gs.ticks < gs.brokeToastUntil {
+		return
+	}
+	gs.brokeToastUntil = gs.ticks + toastDuration
+	gs.showToast("NOT ENOUGH MONEY")
+}
@@ -0,0 +1,186 @@
+package game
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/contracts"
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/ui"
+)
+
+// reserveFuel is the fuel (litres) a resumed car is given if the last run ended with the tank nearly dry,
+// so a session can never start stranded
+const reserveFuel = 5.0
+
+// RestoreFromProfile carries the profile's level and XP, needs, money, licence, contracts and lifetime cars passed into a new session.
+// The car's fuel is already restored, as SelectedCar is the profile's own car.
+func (gs *GameplayScreen) RestoreFromProfile(p *profile.PlayerProfile) {
+	// Saves from before XP start at the bottom of the level they had reached
+	curve := progression.Curve()
+	gs.Level = max(1, p.Level)
+	gs.XP = max(p.XP, curve.XPForLevel(gs.Level))
+	gs.Level = max(gs.Level, curve.LevelForXP(gs.XP))
+	gs.sessionStartLevel = gs.Level
+	gs.sessionStartXP = gs.XP
+
+	gs.TotalCarsPassed = p.TotalCarsPassed
+	gs.sessionStartCarsPassed = p.TotalCarsPassed
+
+	gs.FoodCapacity = p.FoodCapacity
+	gs.FoodLevel = p.FoodLevel
+	gs.SleepCapacity = p.SleepCapacity
+	gs.SleepLevel = p.SleepLevel
+	gs.ToiletLevel = p.ToiletLevel
+
+	gs.wallet = economy.NewWallet(p.Money)
+	gs.sessionStartMoney = p.Money
+
+	gs.bestScore = p.BestScores[gs.levelData.Name]
+	gs.licence = p.Licence
+	gs.licence.Offences = slices.Clone(p.Licence.Offences)
+	gs.activeContracts = append([]contracts.Contract(nil), p.Contracts...)
+	gs.deliveriesCompleted = p.DeliveriesCompleted
+
+	if p.Difficulty != "" {
+		gs.difficulty = p.Difficulty
+	}
+	gs.checkpoint = gs.levelStartCheckpoint()
+
+	selectedCar := gs.playerCar.SelectedCar
+	if selectedCar != nil && selectedCar.FuelLevel < reserveFuel {
+		selectedCar.FuelLevel = min(reserveFuel, selectedCar.TankCapacity())
+	}
+}
+
+// RecordToProfile merges the session's stats into the profile: lifetime totals, money, XP and levels
+// are added to, while needs and the level being played are overwritten with where the run ended
+func (gs *GameplayScreen) RecordToProfile(p *profile.PlayerProfile) {
+	p.SessionsPlayed++
+	p.DistanceTravelled += gs.DistanceTravelled - gs.sessionStartDistance
+	p.TotalCarsPassed += gs.TotalCarsPassed - gs.sessionStartCarsPassed
+	p.TotalCrashes += gs.Crashes - gs.sessionStartCrashes
+	p.Money = max(0, p.Money+gs.wallet.Balance-gs.sessionStartMoney)
+	if gs.score.Score > p.BestScores[gs.levelData.Name] {
+		if p.BestScores == nil {
+			p.BestScores = make(map[string]int)
+		}
+		p.BestScores[gs.levelData.Name] = gs.score.Score
+	}
+
+	p.Contracts = append([]contracts.Contract(nil), gs.activeContracts...)
+	p.DeliveriesCompleted = gs.deliveriesCompleted
+	p.Licence = gs.licence
+	p.CarsStolen += gs.carsStolen
+	p.Escapes += gs.escapes
+	p.Arrests += gs.arrests
+	if gs.taxi != nil {
+		p.TaxiRating = gs.taxi.careerRating
+		p.TaxiTrips = gs.taxi.careerTrips
+	}
+
+	p.Level = max(1, p.Level+gs.Level-gs.sessionStartLevel)
+	p.XP = max(p.XP+gs.XP-gs.sessionStartXP, progression.Curve().XPForLevel(p.Level))
+	p.CurrentLevel = gs.levelIndex
+	p.Difficulty = gs.difficulty
+
+	p.FoodCapacity = gs.FoodCapacity
+	p.FoodLevel = gs.FoodLevel
+	p.SleepCapacity = gs.SleepCapacity
+	p.SleepLevel = gs.SleepLevel
+	p.ToiletLevel = gs.ToiletLevel
+
+	// Fuel burnt this run is already in the tank reading: SelectedCar is the profile's own car
+}
+
+// exitName is how an exit destination is shown to the player: the level it leads to, e.g. "2" for "2.json"
+func exitName(destination string) string {
+	return strings.TrimSuffix(filepath.Base(destination), filepath.Ext(destination))
+}
+
+// checkExitTaken reports whether the player has driven up an exit slip road (a "G" layby lane)
+// past its halfway point, and records where the exit leads
+func (gs *GameplayScreen) checkExitTaken(segment RoadSegment, segmentIdx int) bool {
+	if gs.onFoot || segmentIdx < 0 || !gs.inLayby() {
+		return false
+	}
+	if segment.RoadTypes[0] != "G" || gs.playerCar.Y > segment.Y-300 {
+		return false
+	}
+
+	for _, exit := range gs.levelData.Exits {
+		if exit.Segment == segmentIdx {
+			gs.exitTaken = exit.Destination
+			return true
+		}
+	}
+	return false
+}
+
+// endRun reports the run's results through onGameEnd. Only the first call has any effect.
+func (gs *GameplayScreen) endRun(outcome ui.RunOutcome) {
+	if gs.ended {
+		return
+	}
+	gs.ended = true
+	gs.cleanupTraffic()
+
+	if gs.onGameEnd != nil {
+		gs.onGameEnd(gs.results(outcome))
+	}
+}
+
+// results summarises the session so far
+func (gs *GameplayScreen) results(outcome ui.RunOutcome) ui.RunResults {
+	duration := time.Duration(gs.ticks-gs.sessionStartTicks) * time.Second / 60
+	miles := gs.DistanceTravelled - gs.sessionStartDistance
+
+	averageSpeed := 0.0
+	if hours := duration.Hours(); hours > 0 {
+		averageSpeed = miles / hours
+	}
+
+	r := ui.RunResults{
+		Outcome:         outcome,
+		LevelName:       gs.levelData.Name,
+		Mode:            gs.mode,
+		ExitTaken:       gs.exitTaken,
+		Miles:           miles,
+		CarsPassed:      gs.TotalCarsPassed - gs.sessionStartCarsPassed,
+		Crashes:         gs.Crashes - gs.sessionStartCrashes,
+		FuelUsed:        gs.fuelUsed,
+		AverageSpeedMPH: averageSpeed,
+		Duration:        duration,
+		Level:           gs.Level,
+		LevelsGained:    gs.Level - gs.sessionStartLevel,
+		XPGained:        gs.XP - gs.sessionStartXP,
+		XP:              gs.XP,
+		NextLevelXP:     progression.Curve().XPForLevel(gs.Level + 1),
+		LevelProgress:   gs.levelProgress(),
+		MoneyEarned:     gs.wallet.Earned,
+		MoneySpent:      gs.wallet.Spent,
+		Balance:         gs.wallet.Balance,
+		Score:           gs.score.Score,
+		BestCombo:       gs.score.BestCombo,
+		NearMisses:      gs.score.NearMisses,
+		BestScore:       max(gs.bestScore, gs.score.Score),
+		NewBest:         gs.score.Score > gs.bestScore,
+	}
+	if gs.trial != nil {
+		r.TimeTrial = true
+		r.TrialTime, r.BestTime, r.NewBestTime = gs.trialResults()
+	}
+	if gs.taxi != nil {
+		gs.taxiResults(&r)
+	}
+	if gs.hgv != nil {
+		gs.hgvResults(&r)
+	}
+	gs.policeResults(&r)
+	gs.wantedResults(&r)
+	return r
+}
@@ -0,0 +1,445 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/contracts"
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/scoring"
+)
+
+// SnapshotVersion is the snapshot format written by this build
+const SnapshotVersion = 1
+
+// Reasons a snapshot was taken
+const (
+	SnapshotLayby = "layby" // Saved while parked in a layby
+	SnapshotPause = "pause" // Saved from the pause menu
+	SnapshotCrash = "crash" // Written when the game panicked, to reproduce the bug
+)
+
+// Snapshot is the full state of a GameplayScreen, enough to resume a level exactly where it was saved
+type Snapshot struct {
+	Version    int       `json:"version"`
+	Reason     string    `json:"reason"`
+	Created    time.Time `json:"created"`
+	LevelIndex int       `json:"level_index"`
+	LevelFile  string    `json:"level_file"`
+	Seed       int64     `json:"seed"`  // Seed the traffic RNG was reseeded with when the snapshot was taken
+	Ticks      int64     `json:"ticks"` // Game clock; every timer below is relative to it
+
+	Player  PlayerSnapshot    `json:"player"`
+	CameraX float64           `json:"camera_x"`
+	CameraY float64           `json:"camera_y"`
+	OnFoot  bool              `json:"on_foot"`
+	Ped     *PedSnapshot      `json:"ped,omitempty"`
+	Traffic []TrafficSnapshot `json:"traffic"`
+
+	AutoDrive               bool  `json:"auto_drive"`
+	AutoDriveLane           int   `json:"auto_drive_lane"`
+	LastAutoDriveLaneChange int64 `json:"last_auto_drive_lane_change"`
+	LastSpawnTime           int64 `json:"last_spawn_time"`
+	SpawnCooldown           int64 `json:"spawn_cooldown"`
+	LastCrashTime           int64 `json:"last_crash_time"`
+
+	Checkpoint        *Checkpoint `json:"checkpoint,omitempty"`
+	Difficulty        string      `json:"difficulty"`
+	RespawnGraceUntil int64       `json:"respawn_grace_until"`
+
+	// Session stats. Level, XP and Money are where the profile stood at the save; a resumed run
+	// takes them from the profile instead, so only a replay without one uses them.
+	DistanceTravelled float64 `json:"distance_travelled"`
+	TotalCarsPassed   int     `json:"total_cars_passed"`
+	Level             int     `json:"level"`
+	XP                int     `json:"xp"`
+	CleanMiles        float64 `json:"clean_miles"`
+	Crashes           int     `json:"crashes"`
+	Money             float64 `json:"money"`
+
+	Score *scoring.Engine `json:"score,omitempty"`
+
+	// Delivery contracts being carried
+	Contracts           []contracts.Contract `json:"contracts,omitempty"`
+	DeliveriesCompleted int                  `json:"deliveries_completed"`
+
+	// Hitchhikers still waiting, and those riding with the player
+	Hitchhikers []Hitchhiker `json:"hitchhikers,omitempty"`
+	Passengers  []Hitchhiker `json:"passengers,omitempty"`
+
+	// Need meters
+	SleepCapacity float64 `json:"sleep_capacity"`
+	SleepLevel    float64 `json:"sleep_level"`
+	FoodCapacity  float64 `json:"food_capacity"`
+	FoodLevel     float64 `json:"food_level"`
+	ToiletLevel   float64 `json:"toilet_level"`
+
+	// Need effects in progress (see needs.go)
+	NeedWarnings    int     `json:"need_warnings"`
+	PullingOver     bool    `json:"pulling_over"`
+	JerryCanReadyAt int64   `json:"jerry_can_ready_at"`
+	MicrosleepUntil int64   `json:"microsleep_until"`
+	MicrosleepSteer float64 `json:"microsleep_steer"`
+}
+
+// PlayerSnapshot is the player's car
+type PlayerSnapshot struct {
+	X             float64  `json:"x"`
+	Y             float64  `json:"y"`
+	Speed         float64  `json:"speed"`
+	VelocityX     float64  `json:"velocity_x"`
+	VelocityY     float64  `json:"velocity_y"`
+	SteeringAngle float64  `json:"steering_angle"`
+	Car           *car.Car `json:"car"` // Includes the fuel level
+}
+
+// PedSnapshot is the player while on foot
+type PedSnapshot struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Speed float64 `json:"speed"`
+}
+
+// TrafficSnapshot is one live traffic car with its driver
+type TrafficSnapshot struct {
+	ID                 string     `json:"id"`
+	DriverName         string     `json:"driver_name"`
+	CarModel           *car.Car   `json:"car_model"`
+	Mass               float64    `json:"mass"`
+	X                  float64    `json:"x"`
+	Y                  float64    `json:"y"`
+	VelocityX          float64    `json:"velocity_x"`
+	VelocityY          float64    `json:"velocity_y"`
+	SteeringAngle      float64    `json:"steering_angle"`
+	TargetSpeed        float64    `json:"target_speed"`
+	Acceleration       float64    `json:"acceleration"`
+	Deceleration       float64    `json:"deceleration"`
+	Lane               int        `json:"lane"`
+	TargetLane         int        `json:"target_lane"`
+	LaneProgress       float64    `json:"lane_progress"`
+	Color              color.RGBA `json:"color"`
+	LastLaneChangeTime int64      `json:"last_lane_change_time"`
+	Passed             bool       `json:"passed"`
+	NearMiss           bool       `json:"near_miss"`
+	Touched            bool       `json:"touched"`
+	Police             bool       `json:"police,omitempty"`
+}
+
+// TakeSnapshot captures the current state. The traffic RNG is reseeded with a fresh seed
+// that is stored in the snapshot, so play continues identically after a restore.
+func (gs *GameplayScreen) TakeSnapshot(reason string) *Snapshot {
+	seed := gs.rng.Int63()
+	gs.rng = rand.New(rand.NewSource(seed))
+	gs.rngSeed = seed
+
+	selectedCar := gs.playerCar.SelectedCar
+	if selectedCar != nil {
+		selectedCar = selectedCar.Clone()
+	}
+
+	snap := &Snapshot{
+		Version:    SnapshotVersion,
+		Reason:     reason,
+		Created:    time.Now(),
+		LevelIndex: gs.levelIndex,
+		Seed:       seed,
+		Ticks:      gs.ticks,
+		Player: PlayerSnapshot{
+			X:             gs.playerCar.X,
+			Y:             gs.playerCar.Y,
+			Speed:         gs.playerCar.Speed,
+			VelocityX:     gs.playerCar.VelocityX,
+			VelocityY:     gs.playerCar.VelocityY,
+			SteeringAngle: gs.playerCar.SteeringAngle,
+			Car:           selectedCar,
+		},
+		CameraX: gs.cameraX,
+		CameraY: gs.cameraY,
+		OnFoot:  gs.onFoot,
+
+		AutoDrive:               gs.autoDrive,
+		AutoDriveLane:           gs.autoDriveLane,
+		LastAutoDriveLaneChange: gs.lastAutoDriveLaneChange,
+		LastSpawnTime:           gs.lastSpawnTime,
+		SpawnCooldown:           gs.spawnCooldown,
+		LastCrashTime:           gs.lastCrashTime,
+
+		Difficulty:        gs.difficulty,
+		RespawnGraceUntil: gs.respawnGraceUntil,
+
+		DistanceTravelled: gs.DistanceTravelled,
+		TotalCarsPassed:   gs.TotalCarsPassed,
+		Level:             gs.Level,
+		XP:                gs.XP,
+		CleanMiles:        gs.cleanMiles,
+		Crashes:           gs.Crashes,
+		Money:             gs.wallet.Balance,
+
+		Score: gs.score,
+
+		Contracts:           append([]contracts.Contract(nil), gs.activeContracts...),
+		DeliveriesCompleted: gs.deliveriesCompleted,
+
+		Hitchhikers: append([]Hitchhiker(nil), gs.hitchhikers...),
+		Passengers:  append([]Hitchhiker(nil), gs.passengers...),
+
+		SleepCapacity: gs.SleepCapacity,
+		SleepLevel:    gs.SleepLevel,
+		FoodCapacity:  gs.FoodCapacity,
+		FoodLevel:     gs.FoodLevel,
+		ToiletLevel:   gs.ToiletLevel,
+
+		NeedWarnings:    gs.needWarnings,
+		PullingOver:     gs.pullingOver,
+		JerryCanReadyAt: gs.jerryCanReadyAt,
+		MicrosleepUntil: gs.microsleepUntil,
+		MicrosleepSteer: gs.microsleepSteer,
+	}
+
+	checkpoint := gs.checkpoint
+	snap.Checkpoint = &checkpoint
+
+	if gs.onFoot && gs.playerPed != nil {
+		snap.Ped = &PedSnapshot{X: gs.playerPed.X, Y: gs.playerPed.Y, Speed: gs.playerPed.Speed}
+	}
+
+	gs.trafficMutex.RLock()
+	snap.Traffic = make([]TrafficSnapshot, 0, len(gs.traffic))
+	for _, tc := range gs.traffic {
+		var carModel *car.Car
+		if tc.CarModel != nil {
+			carModel = tc.CarModel.Clone()
+		}
+		snap.Traffic = append(snap.Traffic, TrafficSnapshot{
+			ID:                 tc.ID,
+			DriverName:         tc.DriverName,
+			CarModel:           carModel,
+			Mass:               tc.Mass,
+			X:                  tc.X,
+			Y:                  tc.Y,
+			VelocityX:          tc.VelocityX,
+			VelocityY:          tc.VelocityY,
+			SteeringAngle:      tc.SteeringAngle,
+			TargetSpeed:        tc.TargetSpeed,
+			Acceleration:       tc.Acceleration,
+			Deceleration:       tc.Deceleration,
+			Lane:               tc.Lane,
+			TargetLane:         tc.TargetLane,
+			LaneProgress:       tc.LaneProgress,
+			Color:              tc.Color,
+			LastLaneChangeTime: tc.LastLaneChangeTime,
+			Passed:             tc.Passed,
+			NearMiss:           tc.NearMiss,
+			Touched:            tc.Touched,
+			Police:             tc.Police,
+		})
+	}
+	gs.trafficMutex.RUnlock()
+
+	return snap
+}
+
+// RestoreSnapshot puts the run back into the state a snapshot was taken in.
+// The screen must already be showing the snapshot's level, restored from the profile: the player's level,
+// XP and money stay as the profile has them now, since it may have moved on since the save.
+func (gs *GameplayScreen) RestoreSnapshot(snap *Snapshot) error {
+	if snap.Version > SnapshotVersion {
+		return fmt.Errorf("snapshot version %d is newer than this game (version %d)", snap.Version, SnapshotVersion)
+	}
+
+	gs.rng = rand.New(rand.NewSource(snap.Seed))
+	gs.rngSeed = snap.Seed
+	gs.ticks = snap.Ticks
+
+	gs.playerCar.X = snap.Player.X
+	gs.playerCar.Y = snap.Player.Y
+	gs.playerCar.Speed = snap.Player.Speed
+	gs.playerCar.VelocityX = snap.Player.VelocityX
+	gs.playerCar.VelocityY = snap.Player.VelocityY
+	gs.playerCar.SteeringAngle = snap.Player.SteeringAngle
+	if snap.Player.Car != nil {
+		// The profile's car keeps anything done to it since the save (such as upgrades);
+		// only the fuel in the tank comes from the snapshot
+		if gs.playerCar.SelectedCar == nil {
+			gs.playerCar.SelectedCar = snap.Player.Car.Clone()
+		} else {
+			gs.playerCar.SelectedCar.FuelLevel = min(snap.Player.Car.FuelLevel, gs.playerCar.SelectedCar.TankCapacity())
+		}
+		gs.applyCarStats()
+	}
+	gs.cameraX = snap.CameraX
+	gs.cameraY = snap.CameraY
+
+	gs.onFoot = snap.OnFoot && snap.Ped != nil
+	gs.playerPed = nil
+	if gs.onFoot {
+		gs.playerPed = &PlayerPed{
+			X:      snap.Ped.X,
+			Y:      snap.Ped.Y,
+			Speed:  snap.Ped.Speed,
+			Sprite: gs.createPedSprite(),
+		}
+	}
+
+	gs.autoDrive = snap.AutoDrive
+	gs.autoDriveLane = snap.AutoDriveLane
+	gs.lastAutoDriveLaneChange = snap.LastAutoDriveLaneChange
+	gs.lastSpawnTime = snap.LastSpawnTime
+	gs.spawnCooldown = snap.SpawnCooldown
+	gs.lastCrashTime = snap.LastCrashTime
+
+	gs.DistanceTravelled = snap.DistanceTravelled
+	gs.TotalCarsPassed = snap.TotalCarsPassed
+	gs.cleanMiles = snap.CleanMiles
+	gs.Crashes = snap.Crashes
+
+	// Only progress made after the restore is new to the profile
+	gs.sessionStartDistance = snap.DistanceTravelled
+	gs.sessionStartCarsPassed = snap.TotalCarsPassed
+	gs.sessionStartCrashes = snap.Crashes
+	if snap.Score != nil {
+		score := *snap.Score
+		gs.score = &score
+	}
+	gs.activeContracts = append([]contracts.Contract(nil), snap.Contracts...)
+	gs.deliveriesCompleted = max(gs.deliveriesCompleted, snap.DeliveriesCompleted)
+	gs.passengers = append([]Hitchhiker(nil), snap.Passengers...)
+	if snap.Hitchhikers != nil {
+		gs.hitchhikers = append([]Hitchhiker(nil), snap.Hitchhikers...)
+	}
+	gs.sessionStartTicks = snap.Ticks
+	gs.fuelUsed = 0
+
+	if snap.Difficulty != "" {
+		gs.difficulty = snap.Difficulty
+	}
+	gs.respawnGraceUntil = snap.RespawnGraceUntil
+	gs.checkpoint = gs.levelStartCheckpoint()
+	if snap.Checkpoint != nil {
+		gs.checkpoint = *snap.Checkpoint
+	}
+
+	gs.SleepCapacity = snap.SleepCapacity
+	gs.SleepLevel = snap.SleepLevel
+	gs.FoodCapacity = snap.FoodCapacity
+	gs.FoodLevel = snap.FoodLevel
+	gs.ToiletLevel = snap.ToiletLevel
+
+	// A broken down car is found broken down again on the first tick, from its empty tank
+	gs.needWarnings = snap.NeedWarnings
+	gs.pullingOver = snap.PullingOver
+	gs.brokenDown = snap.JerryCanReadyAt > 0
+	gs.jerryCanReadyAt = snap.JerryCanReadyAt
+	gs.microsleepUntil = snap.MicrosleepUntil
+	gs.microsleepSteer = snap.MicrosleepSteer
+
+	traffic := make([]*TrafficCar, 0, len(snap.Traffic))
+	for _, ts := range snap.Traffic {
+		headshotImg, _ := assets.Default.Headshot(trafficCharacterID(ts.ID))
+		traffic = append(traffic, &TrafficCar{
+			X:                  ts.X,
+			Y:                  ts.Y,
+			VelocityX:          ts.VelocityX,
+			VelocityY:          ts.VelocityY,
+			SteeringAngle:      ts.SteeringAngle,
+			TargetSpeed:        ts.TargetSpeed,
+			Acceleration:       ts.Acceleration,
+			Deceleration:       ts.Deceleration,
+			Lane:               ts.Lane,
+			TargetLane:         ts.TargetLane,
+			LaneProgress:       ts.LaneProgress,
+			Color:              ts.Color,
+			LastLaneChangeTime: ts.LastLaneChangeTime,
+			Passed:             ts.Passed,
+			NearMiss:           ts.NearMiss,
+			Touched:            ts.Touched,
+			Police:             ts.Police,
+			ID:                 ts.ID,
+			DriverName:         ts.DriverName,
+			CarModel:           ts.CarModel,
+			Mass:               ts.Mass,
+			Headshot:           headshotImg,
+		})
+	}
+
+	gs.trafficMutex.Lock()
+	gs.traffic = traffic
+	gs.trafficMutex.Unlock()
+
+	return nil
+}
+
+// restoreSnapshotProgress takes the player's level, XP and money from a snapshot, for a replay
+// with no profile to take them from
+func (gs *GameplayScreen) restoreSnapshotProgress(snap *Snapshot) {
+	gs.Level = max(1, snap.Level)
+	gs.XP = max(snap.XP, progression.Curve().XPForLevel(gs.Level)) // Snapshots from before XP have none
+	gs.sessionStartLevel = gs.Level
+	gs.sessionStartXP = gs.XP
+	gs.wallet = economy.NewWallet(snap.Money)
+	gs.sessionStartMoney = snap.Money
+}
+
+// inLayby reports whether the player's car is in a layby lane (position 0 of the level line)
+func (gs *GameplayScreen) inLayby() bool {
+	segment, _ := gs.getCurrentRoadSegment()
+	if len(segment.LanePositions) == 0 || segment.LanePositions[0] != 0 {
+		return false
+	}
+	return gs.getCurrentLane(segment, 80.0) == 0
+}
+
+// canSaveAtLayby reports whether the player is parked in a layby, where the game can be saved
+func (gs *GameplayScreen) canSaveAtLayby() bool {
+	return gs.onSave != nil && !gs.onFoot && !gs.wanted.Wanted() && gs.pursuer == nil && math.Abs(gs.playerCar.VelocityY) < 0.5 && gs.inLayby()
+}
+
+// saveGame takes a snapshot and hands it to the save callback. Chases and pull-overs are not part of
+// a snapshot, so there is no saving while the police are after the player.
+func (gs *GameplayScreen) saveGame(reason string) {
+	if gs.onSave == nil {
+		gs.showToast("SAVING IS NOT AVAILABLE")
+		return
+	}
+	if gs.wanted.Wanted() || gs.pursuer != nil {
+		gs.showToast("CAN'T SAVE WHILE THE POLICE ARE AFTER YOU")
+		return
+	}
+	if err := gs.onSave(gs.TakeSnapshot(reason)); err != nil {
+		gs.showToast("SAVE FAILED: %v", err)
+		return
+	}
+	gs.showToast("GAME SAVED")
+}
+
+// writeSnapshot saves a snapshot to a JSON file
+func writeSnapshot(path string, snap *Snapshot) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	return profile.WriteFileAtomic(path, data)
+}
+
+// readSnapshot loads a snapshot from a JSON file
+func readSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &snap, nil
+}
@@ -0,0 +1,288 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/data"
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/taxi"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// taxiHailRange is how close (px) the cab has to stop to a customer for them to get in
+const taxiHailRange = 120.0
+
+// TaxiCustomer is someone waiting at a layby for a cab to another layby further up the level
+type TaxiCustomer struct {
+	Name        string  `json:"name"`
+	CharacterID string  `json:"character_id"` // Headshot character
+	X           float64 `json:"x"`
+	Y           float64 `json:"y"`
+	Destination int     `json:"destination"` // Segment index of the layby they want
+}
+
+// taxiShift is a taxi mode run: the customers waiting, the trip on the meter and how the shift is going
+type taxiShift struct {
+	customers []TaxiCustomer
+	customer  *TaxiCustomer // Aboard, or nil
+	trip      *taxi.Trip    // The aboard customer's meter
+
+	trips       int
+	fares       float64
+	ratingTotal float64 // Sum of this shift's ratings
+
+	careerRating float64 // Average over every trip, this shift's included
+	careerTrips  int
+}
+
+// taxiCab picks the company cab for a driver with this rating and number of trips.
+// The same tier always gives the same cab, with a full tank.
+func taxiCab(rating float64, trips int) *car.Car {
+	tier := taxi.CabTier(rating, trips)
+	h := fnv.New64a()
+	h.Write([]byte("taxi/" + tier.Category))
+	cab := models.CarInventory.GetRandomCarByCategory(rand.New(rand.NewSource(int64(h.Sum64()))), []string{tier.Category}).Clone()
+	cab.FuelLevel = cab.TankCapacity()
+	return cab
+}
+
+// startTaxiShift makes the run a taxi shift for a driver with this career so far.
+// Drivers start the shift rested, and customers take the hitchhikers' place at the laybys.
+func (gs *GameplayScreen) startTaxiShift(careerRating float64, careerTrips int) {
+	gs.mode = ModeTaxi
+	gs.SleepLevel = gs.SleepCapacity
+	gs.hitchhikers = nil
+	gs.taxi = &taxiShift{
+		customers:    gs.spawnTaxiCustomers(),
+		careerRating: careerRating,
+		careerTrips:  careerTrips,
+	}
+}
+
+// spawnTaxiCustomers puts a customer at every layby with another layby after it
+func (gs *GameplayScreen) spawnTaxiCustomers() []TaxiCustomer {
+	var customers []TaxiCustomer
+	laybys := gs.levelData.Checkpoints
+	laneWidth := 80.0
+
+	for i, layby := range laybys {
+		if i+1 >= len(laybys) || layby >= len(gs.roadSegments) {
+			continue
+		}
+
+		h := fnv.New64a()
+		fmt.Fprintf(h, "taxi/%s/%d", gs.levelData.Name, layby)
+		rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+		names, character := data.CommonNames.Male, "man"
+		if rng.Float64() < 0.5 {
+			names, character = data.CommonNames.Female, "woman"
+		}
+
+		segment := gs.roadSegments[layby]
+		leftEdge := -float64(segment.StartLaneIndex) * laneWidth
+		customers = append(customers, TaxiCustomer{
+			Name:        names[rng.Intn(len(names))],
+			CharacterID: fmt.Sprintf("%s%d", character, rng.Intn(4)+1),
+			X:           leftEdge - 20,
+			Y:           segment.Y - 300,
+			Destination: laybys[i+1+rng.Intn(len(laybys)-i-1)],
+		})
+	}
+	return customers
+}
+
+// laybyName is how the layby starting at segment is named to a customer: by its exit if it has one
+func (gs *GameplayScreen) laybyName(segment int) string {
+	end := gs.laybyEnd(segment)
+	for _, exit := range gs.levelData.Exits {
+		if exit.Segment >= segment && exit.Segment <= end {
+			return "EXIT TO " + exitName(exit.Destination)
+		}
+	}
+	for i, layby := range gs.levelData.Checkpoints {
+		if layby == segment {
+			return fmt.Sprintf("LAYBY %d", i+1)
+		}
+	}
+	return "LAYBY"
+}
+
+// laybyEnd returns the last segment of the layby starting at segment
+func (gs *GameplayScreen) laybyEnd(segment int) int {
+	end := segment
+	for end+1 < len(gs.roadSegments) {
+		next := gs.roadSegments[end+1]
+		if len(next.LanePositions) == 0 || next.LanePositions[0] != 0 {
+			break
+		}
+		end++
+	}
+	return end
+}
+
+// updateTaxi runs the meter, picks customers up and drops them off. It ends the shift once the driver
+// is too tired, and reports whether it did.
+func (gs *GameplayScreen) updateTaxi(segmentIdx int, milesThisTick float64) bool {
+	t := gs.taxi
+	if t == nil {
+		return false
+	}
+	if gs.SleepLevel < tiredLevel && t.customer == nil {
+		gs.endRun(ui.RunShiftOver)
+		return true
+	}
+
+	stopped := !gs.onFoot && math.Abs(gs.playerCar.VelocityY) < 0.5
+	if t.customer == nil {
+		if stopped {
+			gs.pickUpTaxiCustomer()
+		}
+		return false
+	}
+
+	speedMPH := gs.playerCar.VelocityY * MPHPerPixelPerFrame
+	t.trip.Update(speedMPH, milesThisTick, gs.playerCar.VelocityY, gs.playerCar.SteeringAngle)
+
+	end := gs.laybyEnd(t.trip.Destination)
+	switch {
+	case stopped && gs.inLayby() && segmentIdx >= t.trip.Destination && segmentIdx <= end:
+		gs.finishTaxiTrip(t.trip.Fare(gs.DistanceTravelled), t.trip.Rating())
+	case segmentIdx > end:
+		gs.showToast("MISSED THE DROP-OFF - %s GOT OUT WITHOUT PAYING", t.customer.Name)
+		gs.finishTaxiTrip(0, taxi.MinRating)
+	}
+	return false
+}
+
+// pickUpTaxiCustomer lets in the customer the stopped cab is beside, if there is one
+func (gs *GameplayScreen) pickUpTaxiCustomer() {
+	t := gs.taxi
+	for i, c := range t.customers {
+		if math.Hypot(c.X-gs.playerCar.X, c.Y-gs.playerCar.Y) >= taxiHailRange {
+			continue
+		}
+
+		t.customers = append(t.customers[:i], t.customers[i+1:]...)
+		t.customer = &c
+		t.trip = taxi.NewTrip(c.Name, gs.laybyName(c.Destination), c.Destination, gs.DistanceTravelled, gs.playerCar.VelocityY, gs.playerCar.SteeringAngle)
+		gs.showToast("%s GOT IN - TO %s", c.Name, t.trip.DestinationName)
+		return
+	}
+}
+
+// finishTaxiTrip takes the fare and rating for the trip on the meter and empties the cab
+func (gs *GameplayScreen) finishTaxiTrip(fare, rating float64) {
+	t := gs.taxi
+	before := taxi.CabTier(t.careerRating, t.careerTrips)
+
+	if fare > 0 {
+		gs.wallet.Earn(fare)
+		gs.showToast("FARE $%.2f - RATED %.1f STARS", fare, rating)
+	}
+	t.trips++
+	t.fares += fare
+	t.ratingTotal += rating
+	t.careerRating = taxi.AverageRating(t.careerRating, t.careerTrips, rating)
+	t.careerTrips++
+	t.customer = nil
+	t.trip = nil
+
+	if after := taxi.CabTier(t.careerRating, t.careerTrips); after.Category != before.Category {
+		gs.showToast("THE COMPANY WILL GIVE YOU A %s CAB NEXT SHIFT", after.Category)
+	}
+}
+
+// taxiCollision counts a crash against the customer aboard
+func (gs *GameplayScreen) taxiCollision() {
+	if gs.taxi != nil && gs.taxi.trip != nil {
+		gs.taxi.trip.Collision()
+	}
+}
+
+// taxiResults fills in the shift's fares and ratings
+func (gs *GameplayScreen) taxiResults(r *ui.RunResults) {
+	t := gs.taxi
+	r.Taxi = true
+	r.Fares = t.fares
+	r.Trips = t.trips
+	r.TaxiRating = t.careerRating
+	if t.trips > 0 {
+		r.ShiftRating = t.ratingTotal / float64(t.trips)
+	}
+	if next, ok := taxi.NextTier(t.careerRating, t.careerTrips); ok {
+		r.NextCab = fmt.Sprintf("A %s CAB NEEDS A %.1f RATING OVER %d TRIPS", next.Category, next.MinRating, next.MinTrips)
+	}
+}
+
+// drawTaxiCustomers draws the customers hailing the cab, with their name and where they want to go
+func (gs *GameplayScreen) drawTaxiCustomers(screen *ebiten.Image) {
+	t := gs.taxi
+	if t == nil {
+		return
+	}
+	if gs.hitchhikerSprite == nil {
+		gs.hitchhikerSprite = createHitchhikerSprite()
+	}
+	face := text.NewGoXFace(bitmapfont.Face)
+
+	for _, c := range t.customers {
+		screenX := c.X - gs.cameraX - 8
+		screenY := c.Y - gs.cameraY - 8
+		if screenY < -50 || screenY > float64(gs.screenHeight)+50 {
+			continue
+		}
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(screenX, screenY)
+		screen.DrawImage(gs.hitchhikerSprite, op)
+
+		label := "TAXI! " + c.Name + " -> " + gs.laybyName(c.Destination)
+		labelOp := &text.DrawOptions{}
+		labelOp.GeoM.Translate(screenX+8-text.Advance(label, face)/2, screenY-20)
+		labelOp.ColorScale.ScaleWithColor(color.RGBA{255, 215, 0, 255})
+		text.Draw(screen, label, face, labelOp)
+	}
+}
+
+// drawTaxiMeter shows the driver's rating and, with a customer aboard, the meter, under the contracts and passengers
+func (gs *GameplayScreen) drawTaxiMeter(screen *ebiten.Image) {
+	t := gs.taxi
+	if t == nil {
+		return
+	}
+	face := text.NewGoXFace(bitmapfont.Face)
+	x := 20.0
+	y := 195.0 + float64(len(gs.activeContracts))*20 + float64(len(gs.passengers))*24 + 10
+	lines := []string{fmt.Sprintf("TAXI  RATING %.1f  %d TRIPS", t.careerRating, t.careerTrips)}
+	if t.trip != nil {
+		lines = append(lines,
+			fmt.Sprintf("METER $%.2f  %s", t.trip.Fare(gs.DistanceTravelled), t.customer.Name),
+			fmt.Sprintf("TO %s  %.1f STARS", t.trip.DestinationName, t.trip.Rating()))
+	}
+
+	for i, line := range lines {
+		if i == 1 && t.customer != nil {
+			if headshot, err := assets.Default.Headshot(t.customer.CharacterID); err == nil {
+				scale := 20 / float64(headshot.Bounds().Dx())
+				op := &ebiten.DrawImageOptions{}
+				op.GeoM.Scale(scale, scale)
+				op.GeoM.Translate(x+200, y+float64(i)*20-4)
+				screen.DrawImage(headshot, op)
+			}
+		}
+		op := &text.DrawOptions{}
+		op.GeoM.Translate(x, y+float64(i)*20)
+		op.ColorScale.ScaleWithColor(color.RGBA{255, 215, 0, 255})
+		text.Draw(screen, line, face, op)
+	}
+}
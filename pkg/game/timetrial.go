@@ -0,0 +1,242 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// ghostSampleTicks is how often the player's car is recorded for the ghost (20 times a second)
+const ghostSampleTicks = 3
+
+// ghostAlpha is how solid the ghost car is drawn
+const ghostAlpha = 0.4
+
+// GhostFrame is the player's car at one moment of a recorded run
+type GhostFrame struct {
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	Steering float64 `json:"steering"`
+}
+
+// Ghost is a recorded time trial: its time, the splits at each layby and the path the car took
+type Ghost struct {
+	Level  string       `json:"level"`
+	Ticks  int64        `json:"ticks"`  // Finishing time
+	Splits []int64      `json:"splits"` // Time on reaching each layby, in order
+	Frames []GhostFrame `json:"frames"` // The car every ghostSampleTicks from the start
+}
+
+// timeTrial is the clock and recordings of a time trial run
+type timeTrial struct {
+	started   bool
+	finished  bool
+	startTick int64  // gs.ticks when the clock started
+	recording *Ghost // This run so far
+	best      *Ghost // The run to beat, played back as the ghost; nil if the level has no recorded run
+}
+
+// startTimeTrial makes the run a time trial against best (nil for none). Traffic is reseeded from
+// the level name, so every attempt on a level starts among the same traffic, with no police (see policeEnforcing).
+func (gs *GameplayScreen) startTimeTrial(best *Ghost) {
+	gs.mode = ModeTimeTrial
+	h := fnv.New64a()
+	h.Write([]byte(gs.levelData.Name))
+	seed := int64(h.Sum64())
+	gs.rng = rand.New(rand.NewSource(seed))
+	gs.rngSeed = seed
+	gs.spawnCooldown = 215 + gs.rng.Int63n(143)
+	gs.lastSpawnTime = 0
+
+	gs.trafficMutex.Lock()
+	gs.cleanupTraffic()
+	gs.trafficMutex.Unlock()
+	gs.spawnInitialTraffic()
+
+	gs.trial = &timeTrial{
+		recording: &Ghost{Level: gs.levelData.Name},
+		best:      best,
+	}
+}
+
+// updateTimeTrial starts the clock when the car pulls away and records the ghost as the run goes on
+func (gs *GameplayScreen) updateTimeTrial() {
+	t := gs.trial
+	if t == nil || t.finished {
+		return
+	}
+	if !t.started {
+		if gs.playerCar.VelocityY <= 0 {
+			return
+		}
+		t.started = true
+		t.startTick = gs.ticks
+	}
+
+	if (gs.ticks-t.startTick)%ghostSampleTicks == 0 {
+		t.recording.Frames = append(t.recording.Frames, GhostFrame{
+			X:        gs.playerCar.X,
+			Y:        gs.playerCar.Y,
+			Steering: gs.playerCar.SteeringAngle,
+		})
+	}
+}
+
+// trialElapsed returns the time on the clock in ticks
+func (gs *GameplayScreen) trialElapsed() int64 {
+	t := gs.trial
+	switch {
+	case t == nil || !t.started:
+		return 0
+	case t.finished:
+		return t.recording.Ticks
+	default:
+		return gs.ticks - t.startTick
+	}
+}
+
+// recordSplit notes the time on reaching a layby and shows it against the ghost's split there
+func (gs *GameplayScreen) recordSplit() {
+	t := gs.trial
+	if t == nil || !t.started || t.finished {
+		return
+	}
+
+	elapsed := gs.trialElapsed()
+	n := len(t.recording.Splits)
+	t.recording.Splits = append(t.recording.Splits, elapsed)
+
+	if t.best != nil && n < len(t.best.Splits) {
+		gs.showToast("SPLIT %d  %s  (%s)", n+1, formatTrialTime(elapsed), formatSplitDelta(elapsed-t.best.Splits[n]))
+		return
+	}
+	gs.showToast("SPLIT %d  %s", n+1, formatTrialTime(elapsed))
+}
+
+// finishTimeTrial stops the clock on reaching the end of the level
+func (gs *GameplayScreen) finishTimeTrial() {
+	t := gs.trial
+	if t == nil || !t.started || t.finished {
+		return
+	}
+	t.recording.Ticks = gs.ticks - t.startTick
+	t.finished = true
+}
+
+// finishedGhost returns the recording of this run if it was a time trial driven to the end, or nil
+func (gs *GameplayScreen) finishedGhost() *Ghost {
+	if gs.trial == nil || !gs.trial.finished {
+		return nil
+	}
+	return gs.trial.recording
+}
+
+// trialResults returns the run's time (zero if it did not finish), the best time on the level
+// including this run, and whether this run set it
+func (gs *GameplayScreen) trialResults() (trialTime, bestTime time.Duration, newBest bool) {
+	t := gs.trial
+	if t.best != nil {
+		bestTime = ticksDuration(t.best.Ticks)
+	}
+	if !t.finished {
+		return 0, bestTime, false
+	}
+
+	trialTime = ticksDuration(t.recording.Ticks)
+	if t.best == nil || t.recording.Ticks < t.best.Ticks {
+		return trialTime, trialTime, true
+	}
+	return trialTime, bestTime, false
+}
+
+// drawGhost draws the best run's car where it was at this time on the clock
+func (gs *GameplayScreen) drawGhost(screen *ebiten.Image) {
+	t := gs.trial
+	if t == nil || t.best == nil || len(t.best.Frames) < 2 {
+		return
+	}
+
+	pos := float64(gs.trialElapsed()) / ghostSampleTicks
+	i := int(pos)
+	if i >= len(t.best.Frames)-1 {
+		return // The ghost has crossed the line
+	}
+
+	a, b := t.best.Frames[i], t.best.Frames[i+1]
+	f := pos - float64(i)
+	gs.drawCarSprite(screen, a.X+(b.X-a.X)*f, a.Y+(b.Y-a.Y)*f, a.Steering+(b.Steering-a.Steering)*f, ghostAlpha)
+}
+
+// drawTimeTrial draws the clock, and the time to beat, under the score
+func (gs *GameplayScreen) drawTimeTrial(screen *ebiten.Image) {
+	if gs.trial == nil {
+		return
+	}
+	face := text.NewGoXFace(bitmapfont.Face)
+	centerX := float64(gs.screenWidth) / 2
+
+	clock := "TIME " + formatTrialTime(gs.trialElapsed())
+	if gs.trial.best != nil {
+		clock += "  BEST " + formatTrialTime(gs.trial.best.Ticks)
+	}
+	scale := 1.5
+	op := &text.DrawOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(centerX-text.Advance(clock, face)*scale/2, 70)
+	op.ColorScale.ScaleWithColor(color.RGBA{120, 220, 255, 255})
+	text.Draw(screen, clock, face, op)
+}
+
+// ticksDuration converts game ticks (60 a second) to a duration
+func ticksDuration(ticks int64) time.Duration {
+	return time.Duration(ticks) * time.Second / 60
+}
+
+// formatTrialTime formats a time in ticks as m:ss.hh
+func formatTrialTime(ticks int64) string {
+	hundredths := ticks * 100 / 60
+	return fmt.Sprintf("%d:%02d.%02d", hundredths/6000, hundredths/100%60, hundredths%100)
+}
+
+// formatSplitDelta formats the difference from the ghost's split in ticks as +s.hh or -s.hh
+func formatSplitDelta(ticks int64) string {
+	sign := "+"
+	if ticks < 0 {
+		sign = "-"
+		ticks = -ticks
+	}
+	hundredths := ticks * 100 / 60
+	return fmt.Sprintf("%s%d.%02d", sign, hundredths/100, hundredths%100)
+}
+
+// writeGhost saves a recorded run to a JSON file
+func writeGhost(path string, ghost *Ghost) error {
+	data, err := json.Marshal(ghost)
+	if err != nil {
+		return err
+	}
+	return profile.WriteFileAtomic(path, data)
+}
+
+// readGhost loads a recorded run from a JSON file
+func readGhost(path string) (*Ghost, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ghost Ghost
+	if err := json.Unmarshal(data, &ghost); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &ghost, nil
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// toastDuration is how many ticks a toast stays on screen (3 seconds at 60 FPS)
+const toastDuration = 180
+
+// maxToasts is how many toasts are stacked at once; older ones are dropped
+const maxToasts = 3
+
+// toast is a short message shown at the bottom of the gameplay screen
+type toast struct {
+	message string
+	expires int64 // Game tick the toast disappears at
+}
+
+// showToast queues a short message for the player
+func (gs *GameplayScreen) showToast(format string, args ...any) {
+	gs.toasts = append(gs.toasts, toast{
+		message: fmt.Sprintf(format, args...),
+		expires: gs.ticks + toastDuration,
+	})
+	if len(gs.toasts) > maxToasts {
+		gs.toasts = gs.toasts[len(gs.toasts)-maxToasts:]
+	}
+}
+
+// drawToasts renders the queued toasts, newest at the bottom, and drops expired ones.
+// Game ticks stop while paused, so a toast raised from the pause menu stays up until play resumes.
+func (gs *GameplayScreen) drawToasts(screen *ebiten.Image) {
+	live := gs.toasts[:0]
+	for _, t := range gs.toasts {
+		if gs.ticks < t.expires {
+			live = append(live, t)
+		}
+	}
+	gs.toasts = live
+
+	face := text.NewGoXFace(bitmapfont.Face)
+	scale := 1.5
+	lineHeight := 32.0
+	bottomY := float64(gs.screenHeight) - 60
+
+	for i, t := range gs.toasts {
+		y := bottomY - float64(len(gs.toasts)-1-i)*lineHeight
+		w := text.Advance(t.message, face) * scale
+
+		bg := ebiten.NewImage(int(w)+24, int(lineHeight)-4)
+		bg.Fill(color.RGBA{0, 0, 0, 180})
+		bgOp := &ebiten.DrawImageOptions{}
+		bgOp.GeoM.Translate(float64(gs.screenWidth)/2-w/2-12, y-4)
+		screen.DrawImage(bg, bgOp)
+
+		op := &text.DrawOptions{}
+		op.GeoM.Scale(scale, scale)
+		op.GeoM.Translate(float64(gs.screenWidth)/2-w/2, y)
+		op.ColorScale.ScaleWithColor(color.RGBA{255, 255, 200, 255})
+		text.Draw(screen, t.message, face, op)
+	}
+}
@@ -0,0 +1,298 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/data"
+	"github.com/golangdaddy/roadster/pkg/licence"
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/golangdaddy/roadster/pkg/ui"
+	"github.com/golangdaddy/roadster/pkg/wanted"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// A wanted player is chased by as many patrol cars as they have stars, and stopped by roadblocks at the laybys
+const (
+	chaseSightRange    = 1000.0 // How far along the road (px) a patrol car in the chase can see the player
+	chaseSpawnBehind   = 800.0  // How far behind the player (px) reinforcements join the chase
+	chaseSpawnTicks    = 240    // Ticks between reinforcements
+	chaseBoxInDistance = 110.0  // How far ahead of the player (px) a chasing car pulls in to box them in
+	chaseBoxInFactor   = 0.7    // Fraction of the player's speed a chasing car slows to once in front of them
+	bustRange          = 100.0  // How close (px) a patrol car must be to arrest a stopped player
+	bustMPH            = 10.0   // Slower than this, the player can be arrested
+	roadblockStars     = 2      // Wanted level at which the laybys ahead are blocked
+	roadblockAhead     = 1500.0 // Furthest ahead of the player (px) a roadblock is set up
+	roadblockMPH       = 35.0   // A rolling roadblock crawls along at this speed
+)
+
+// stealCar raises the wanted level for taking a traffic car. The caller holds trafficMutex.
+func (gs *GameplayScreen) stealCar(tc *TrafficCar) {
+	gs.carsStolen++
+	if !gs.policeEnforcing() {
+		return
+	}
+
+	stars := wanted.TheftStars
+	if tc.Police {
+		stars = wanted.PoliceTheftStars
+	}
+	gs.wanted.Commit(stars)
+
+	// The chase takes over from any pull-over for speeding
+	gs.pursuer = nil
+	gs.policeStop = false
+	gs.nextChaserAt = gs.ticks
+	gs.showToast("CAR STOLEN - WANTED LEVEL %d", gs.wanted.Stars)
+}
+
+// lyingLow reports whether the player is parked up at a service station, out of sight of the road
+func (gs *GameplayScreen) lyingLow() bool {
+	return gs.atStation >= 0
+}
+
+// chase drives a patrol car after a wanted player: past them in the next lane, then into their lane ahead
+// of them, slowing to box them in. While the player is lying low it drives on at the limit looking for them.
+func (tc *TrafficCar) chase(gs *GameplayScreen) {
+	tcSegment := gs.getSegmentAt(tc.Y)
+	if gs.lyingLow() {
+		lanePosition := tc.Lane
+		if tc.Lane < len(tcSegment.LanePositions) {
+			lanePosition = tcSegment.LanePositions[tc.Lane]
+		}
+		tc.TargetSpeed = (50.0 + float64(lanePosition)*10.0) / MPHPerPixelPerFrame
+		tc.driveAtTargetSpeed()
+		return
+	}
+
+	playerLane := tc.playerLane(gs)
+	lane := playerLane
+	aheadBy := gs.playerCar.Y - tc.Y
+	if aheadBy < chaseBoxInDistance/2 {
+		// Not past yet: overtake on the lane beside the player rather than through them
+		lane = playerLane + 1
+		if lane >= tcSegment.LaneCount {
+			lane = playerLane - 1
+		}
+		lane = max(1, lane)
+	}
+	tc.steerTowards(gs, lane)
+
+	tc.TargetSpeed = gs.playerCar.VelocityY + policeClosingMPH/MPHPerPixelPerFrame
+	if aheadBy >= chaseBoxInDistance {
+		tc.TargetSpeed = gs.playerCar.VelocityY * chaseBoxInFactor
+	}
+	tc.driveAtTargetSpeed()
+}
+
+// holdRoadblock keeps a roadblock car crawling along its lane, side by side with the rest of the roadblock.
+// Once the player has got past, or is no longer wanted, it joins the chase or goes back to patrolling.
+func (tc *TrafficCar) holdRoadblock(gs *GameplayScreen) {
+	if gs.playerCar.Y < tc.Y-collisionHeight || !gs.wanted.Wanted() {
+		tc.Roadblock = false
+		tc.Pursuing = gs.wanted.Wanted()
+		return
+	}
+	tc.TargetSpeed = roadblockMPH / MPHPerPixelPerFrame
+	tc.driveAtTargetSpeed()
+}
+
+// updateChase runs a chase after a wanted player: patrol cars that see them join in, reinforcements
+// arrive and the laybys ahead are blocked. The player escapes by lying low until every star is gone,
+// and is arrested if they stop beside a patrol car.
+func (gs *GameplayScreen) updateChase() {
+	x, y := gs.playerCar.X, gs.playerCar.Y
+	speedMPH := math.Abs(gs.playerCar.VelocityY) * MPHPerPixelPerFrame
+	if gs.onFoot && gs.playerPed != nil {
+		x, y = gs.playerPed.X, gs.playerPed.Y
+		speedMPH = 0
+	}
+	hidden := gs.lyingLow()
+
+	gs.trafficMutex.Lock()
+	chasers := 0
+	seen, cornered := false, false
+	for _, tc := range gs.traffic {
+		if !tc.Police {
+			continue
+		}
+		if !tc.Pursuing && !tc.Roadblock && !hidden && math.Abs(tc.Y-y) < policeSightRange {
+			tc.Pursuing = true
+		}
+		if !tc.Pursuing && !tc.Roadblock {
+			continue
+		}
+		chasers++
+		if !hidden && math.Abs(tc.Y-y) < chaseSightRange {
+			seen = true
+		}
+		if !hidden && speedMPH < bustMPH && math.Hypot(tc.X-x, tc.Y-y) < bustRange {
+			cornered = true
+		}
+	}
+	if !hidden && chasers < gs.wanted.Stars && gs.ticks >= gs.nextChaserAt {
+		gs.spawnChaser()
+		gs.nextChaserAt = gs.ticks + chaseSpawnTicks
+	}
+	if gs.wanted.Stars >= roadblockStars {
+		gs.setUpRoadblock()
+	}
+	gs.trafficMutex.Unlock()
+
+	switch gs.wanted.Update(seen, cornered) {
+	case wanted.StarLost:
+		gs.showToast("THE POLICE ARE LOSING TRACK OF YOU - WANTED LEVEL %d", gs.wanted.Stars)
+	case wanted.Escaped:
+		gs.escapeChase()
+	case wanted.Busted:
+		gs.arrest()
+	}
+}
+
+// rammedPolice raises the wanted level of a wanted player who crashes into a patrol car
+func (gs *GameplayScreen) rammedPolice(hit *TrafficCar) {
+	if hit.Police && gs.wanted.Wanted() {
+		gs.wanted.Commit(wanted.RammingStars)
+		gs.showToast("RAMMED A POLICE CAR - WANTED LEVEL %d", gs.wanted.Stars)
+	}
+}
+
+// spawnChaser brings a patrol car into the chase from behind the player. The caller holds trafficMutex.
+func (gs *GameplayScreen) spawnChaser() {
+	y := gs.playerCar.Y + chaseSpawnBehind
+	segment := gs.getSegmentAt(y)
+	if segment.LaneCount < 2 {
+		return
+	}
+	tc := gs.newPoliceCar(segment, 1+gs.rng.Intn(segment.LaneCount-1), y, gs.playerCar.VelocityY)
+	tc.Pursuing = true
+	gs.traffic = append(gs.traffic, tc)
+}
+
+// setUpRoadblock puts a rolling roadblock across every running lane of the next layby ahead, leaving only
+// the layby itself open. Each layby is blocked once a run. The caller holds trafficMutex.
+func (gs *GameplayScreen) setUpRoadblock() {
+	for _, layby := range gs.levelData.Checkpoints {
+		if gs.roadblocks[layby] || layby >= len(gs.roadSegments) {
+			continue
+		}
+		segment := gs.roadSegments[layby]
+		ahead := gs.playerCar.Y - segment.Y
+		if ahead < roadblockAhead-600 || ahead > roadblockAhead {
+			continue
+		}
+
+		if gs.roadblocks == nil {
+			gs.roadblocks = make(map[int]bool)
+		}
+		gs.roadblocks[layby] = true
+		for lane := 1; lane < segment.LaneCount; lane++ {
+			tc := gs.newPoliceCar(segment, lane, segment.Y-300, roadblockMPH/MPHPerPixelPerFrame)
+			tc.Roadblock = true
+			gs.traffic = append(gs.traffic, tc)
+		}
+		gs.showToast("POLICE ROADBLOCK AHEAD AT %s", gs.laybyName(layby))
+		return
+	}
+}
+
+// newPoliceCar creates a patrol car in lane of segment at world Y y, moving at velocity (px/frame)
+func (gs *GameplayScreen) newPoliceCar(segment RoadSegment, lane int, y, velocity float64) *TrafficCar {
+	laneWidth := 80.0
+	carModel := models.CarInventory.GetRandomCarByCategory(gs.rng, []string{"C3", "C4"})
+	accel, decel := trafficHandling(carModel)
+
+	nameList := data.CommonNames.Male
+	if gs.rng.Float64() > 0.5 {
+		nameList = data.CommonNames.Female
+	}
+	driverName := "PC " + nameList[gs.rng.Intn(len(nameList))]
+	id := fmt.Sprintf("%s-%d", driverName, gs.rng.Intn(1000))
+	headshotImg, _ := assets.Default.Headshot(trafficCharacterID(id))
+
+	return &TrafficCar{
+		X:                  -float64(segment.StartLaneIndex)*laneWidth + float64(lane)*laneWidth + laneWidth/2,
+		Y:                  y,
+		VelocityY:          velocity,
+		TargetSpeed:        velocity,
+		Acceleration:       accel,
+		Deceleration:       decel,
+		Lane:               lane,
+		Color:              policeColor,
+		Police:             true,
+		Passed:             y > gs.playerCar.Y,
+		LastLaneChangeTime: gs.nowMillis(),
+		ID:                 id,
+		DriverName:         driverName,
+		CarModel:           carModel,
+		Mass:               carModel.Weight,
+		Headshot:           headshotImg,
+	}
+}
+
+// escapeChase calls off the chase once the player has lost their last star
+func (gs *GameplayScreen) escapeChase() {
+	gs.trafficMutex.Lock()
+	for _, tc := range gs.traffic {
+		tc.Pursuing = false
+		tc.Roadblock = false
+	}
+	gs.trafficMutex.Unlock()
+
+	gs.escapes++
+	gs.showToast("YOU ESCAPED THE POLICE")
+}
+
+// arrest ends the run with the player in custody: fined for every star, with points on their licence and XP lost
+func (gs *GameplayScreen) arrest() {
+	fine := gs.wallet.ChargeUpTo(gs.wanted.Arrest())
+	gs.arrests++
+	gs.offences++
+	gs.penaltyPoints += wanted.ArrestPoints
+	gs.offenceFines += fine
+	gs.licence.Record(licence.Offence{
+		Kind:   licence.KindArrest,
+		Level:  gs.levelData.Name,
+		Points: wanted.ArrestPoints,
+		Fine:   fine,
+		Date:   time.Now(),
+	})
+	gs.penaliseXP(progression.XPArrestPenalty)
+	gs.endRun(ui.RunBusted)
+}
+
+// wantedResults fills in the run's thefts, escapes and arrest
+func (gs *GameplayScreen) wantedResults(r *ui.RunResults) {
+	r.CarsStolen = gs.carsStolen
+	r.Escapes = gs.escapes
+}
+
+// drawWanted shows the wanted level, and whether the player is lying low, under the score
+func (gs *GameplayScreen) drawWanted(screen *ebiten.Image) {
+	if !gs.wanted.Wanted() {
+		return
+	}
+	label := "WANTED " + strings.Repeat("* ", gs.wanted.Stars) + strings.Repeat("- ", wanted.MaxStars-gs.wanted.Stars)
+	clr := color.RGBA{255, 60, 60, 255}
+	if gs.lyingLow() {
+		label += "LYING LOW"
+		clr = color.RGBA{200, 200, 200, 255}
+	} else if gs.ticks/15%2 == 0 {
+		clr = color.RGBA{80, 140, 255, 255}
+	}
+
+	face := text.NewGoXFace(bitmapfont.Face)
+	scale := 1.5
+	op := &text.DrawOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(float64(gs.screenWidth)/2-text.Advance(label, face)*scale/2, 95)
+	op.ColorScale.ScaleWithColor(clr)
+	text.Draw(screen, label, face, op)
+}
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/progression"
+)
+
+// awardXP adds amount to the player's XP and levels them up if it takes them over a threshold
+func (gs *GameplayScreen) awardXP(amount int) {
+	if amount <= 0 {
+		return
+	}
+	gs.XP += amount
+
+	level := progression.Curve().LevelForXP(gs.XP)
+	for gs.Level < level {
+		gs.Level++
+		gs.showToast("LEVEL UP! LEVEL %d", gs.Level)
+		if unlocks := progression.UnlocksAt(gs.Level); len(unlocks) > 0 {
+			gs.showToast("UNLOCKED: %s", strings.Join(unlocks, ", "))
+		}
+	}
+}
+
+// penaliseXP takes XP for a crash. It never takes the player back down a level.
+func (gs *GameplayScreen) penaliseXP(penalty int) {
+	gs.XP = max(progression.Curve().XPForLevel(gs.Level), gs.XP-penalty)
+	gs.cleanMiles = 0
+}
+
+// addCleanMiles counts miles driven without crashing and awards XP for each whole one
+func (gs *GameplayScreen) addCleanMiles(miles float64) {
+	gs.cleanMiles += miles
+	for gs.cleanMiles >= 1 {
+		gs.cleanMiles--
+		gs.awardXP(progression.XPPerCleanMile)
+	}
+}
+
+// levelProgress returns how far the player's XP is through their current level, 0 to 1
+func (gs *GameplayScreen) levelProgress() float64 {
+	curve := progression.Curve()
+	floor, ceiling := curve.XPForLevel(gs.Level), curve.XPForLevel(gs.Level+1)
+	if !curve.Enabled || gs.Level >= curve.MaxLevel || ceiling <= floor {
+		return 1
+	}
+	return max(0, min(1, float64(gs.XP-floor)/float64(ceiling-floor)))
+}
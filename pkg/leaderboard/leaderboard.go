@@ -0,0 +1,150 @@
+// Package leaderboard keeps the local top scores for each level and game mode
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+)
+
+// FileName is the leaderboard file, kept next to the profiles directory
+const FileName = "leaderboard.json"
+
+// backupExt is added to the file name for the copy of the last good file, kept in case the main one is damaged
+const backupExt = ".bak"
+
+// Size is how many entries each table keeps
+const Size = 10
+
+// Order is how a table is ranked
+type Order int
+
+const (
+	ByScore Order = iota // Highest score first; ties go to the quicker run
+	ByTime               // Quickest run first
+)
+
+// Entry is one run on a table
+type Entry struct {
+	PlayerName   string        `json:"player_name"`
+	HeadshotPath string        `json:"headshot_path"`
+	Score        int           `json:"score"`
+	Time         time.Duration `json:"time"`
+	Miles        float64       `json:"miles"`
+	Car          string        `json:"car"`              // Make and model
+	Replay       string        `json:"replay,omitempty"` // Reference to a recording of the run, if one was kept
+	Date         time.Time     `json:"date"`
+}
+
+// Leaderboards holds every table, each keyed by level and mode, and the file they are saved in
+type Leaderboards struct {
+	Tables map[string][]Entry `json:"tables"`
+
+	path string
+}
+
+// key names the table for a level and mode
+func key(level, mode string) string {
+	return level + "/" + mode
+}
+
+// DefaultPath returns where the leaderboard is kept for profiles saved in profileDir
+func DefaultPath(profileDir string) string {
+	return filepath.Join(filepath.Dir(profileDir), FileName)
+}
+
+// Load reads the leaderboard at path. A missing file gives empty tables. A damaged file is logged and
+// the backup of the last good file is used instead, so one bad write never loses every score.
+func Load(path string) (*Leaderboards, error) {
+	l := &Leaderboards{Tables: make(map[string][]Entry), path: path}
+
+	err := l.read(path)
+	if err == nil || errors.Is(err, fs.ErrNotExist) {
+		return l, nil
+	}
+	log.Printf("Leaderboard %s is unreadable, trying the backup: %v", path, err)
+
+	if backupErr := l.read(path + backupExt); backupErr != nil && !errors.Is(backupErr, fs.ErrNotExist) {
+		return l, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+// read decodes the tables from file
+func (l *Leaderboards) read(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var decoded Leaderboards
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Tables != nil {
+		l.Tables = decoded.Tables
+	}
+	return nil
+}
+
+// Save writes the tables to disk. The file is replaced atomically, and the previous file is kept as the backup.
+func (l *Leaderboards) Save() error {
+	if l.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if previous, err := os.ReadFile(l.path); err == nil && json.Valid(previous) {
+		if err := profile.WriteFileAtomic(l.path+backupExt, previous); err != nil {
+			log.Printf("Failed to back up leaderboard: %v", err)
+		}
+	}
+	return profile.WriteFileAtomic(l.path, data)
+}
+
+// Top returns the table for a level and mode, best first
+func (l *Leaderboards) Top(level, mode string) []Entry {
+	return l.Tables[key(level, mode)]
+}
+
+// Submit adds e to the table for a level and mode, ranked by order, and returns its place (1 for first),
+// or 0 if it did not make the table.
+func (l *Leaderboards) Submit(level, mode string, order Order, e Entry) int {
+	k := key(level, mode)
+	table := append(l.Tables[k], e)
+	sort.SliceStable(table, func(i, j int) bool {
+		if order == ByScore && table[i].Score != table[j].Score {
+			return table[i].Score > table[j].Score
+		}
+		return table[i].Time < table[j].Time
+	})
+
+	rank := 0
+	for i := range table {
+		if table[i] == e {
+			rank = i + 1
+			break
+		}
+	}
+	if len(table) > Size {
+		table = table[:Size]
+	}
+	l.Tables[k] = table
+
+	if rank > Size {
+		return 0
+	}
+	return rank
+}
@@ -0,0 +1,74 @@
+// Package licence keeps a driver's penalty points and record of offences, and decides when too many
+// points suspend their licence
+package licence
+
+import "time"
+
+// Penalties
+const (
+	SuspensionPoints = 12    // Points at which the licence is suspended
+	CourseFee        = 500.0 // Driver improvement course that lifts a suspension and clears the points
+
+	speedTolerance    = 1.1 // Speeds up to 10% over the limit...
+	speedToleranceMPH = 2.0 // ...plus 2 MPH are not prosecuted
+)
+
+// Offence kinds
+const (
+	KindSpeedCamera = "speed_camera" // Caught by a speed camera
+	KindPolice      = "police"       // Clocked and pulled over by a patrol car
+	KindArrest      = "arrest"       // Arrested at the end of a police chase
+)
+
+// Offence is one ticket on a driver's record
+type Offence struct {
+	Kind     string    `json:"kind"` // One of the Kind constants
+	Level    string    `json:"level"`
+	SpeedMPH float64   `json:"speed_mph"`
+	LimitMPH float64   `json:"limit_mph"`
+	Points   int       `json:"points"`
+	Fine     float64   `json:"fine"` // What the driver was charged, which may be less than the penalty if they were short
+	Date     time.Time `json:"date"`
+}
+
+// Licence is a driver's licence: the points on it and every offence that put them there
+type Licence struct {
+	Points    int       `json:"points"`
+	Suspended bool      `json:"suspended"`
+	Offences  []Offence `json:"offences,omitempty"`
+}
+
+// Speeding reports whether speedMPH is far enough over limitMPH to be prosecuted
+func Speeding(speedMPH, limitMPH float64) bool {
+	return speedMPH > limitMPH*speedTolerance+speedToleranceMPH
+}
+
+// SpeedingPenalty returns the points and fine for driving at speedMPH where the limit is limitMPH.
+// The further over, the heavier the penalty.
+func SpeedingPenalty(speedMPH, limitMPH float64) (points int, fine float64) {
+	switch over := speedMPH / limitMPH; {
+	case over >= 1.5:
+		return 6, 1000
+	case over >= 1.25:
+		return 4, 400
+	default:
+		return 3, 100
+	}
+}
+
+// Record adds an offence to the licence and reports whether its points have just suspended it
+func (l *Licence) Record(o Offence) bool {
+	l.Offences = append(l.Offences, o)
+	l.Points += o.Points
+	if l.Suspended || l.Points < SuspensionPoints {
+		return false
+	}
+	l.Suspended = true
+	return true
+}
+
+// CompleteCourse lifts a suspension and wipes the points; the offences stay on record
+func (l *Licence) CompleteCourse() {
+	l.Suspended = false
+	l.Points = 0
+}
@@ -1,5 +1,7 @@
 package car
 
+import "maps"
+
 // Brakes represents the braking system of a car
 type Brakes struct {
 	Type          string  `json:"type"`
@@ -16,12 +18,18 @@ type Car struct {
 	Weight            float64 `json:"weight"`             // in kg
 	FuelCapacity      float64 `json:"fuel_capacity"`      // in liters
 	FuelLevel         float64 `json:"fuel_level"`         // in liters
-	Category          string  `json:"category"`           // C1, C2, C3, C4, C5
+	Category          string  `json:"category"`           // C1, C2, C3, C4, C5 or HGV
 	Accel0to60        float64 `json:"accel_0_60"`         // seconds
 	Accel0to100       float64 `json:"accel_0_100"`        // seconds
 	BHP               int     `json:"bhp"`                // Brake Horsepower
 	BrakingEfficiency float64 `json:"braking_efficiency"` // 0.0 to 1.0
 	Brakes            Brakes  `json:"brakes"`
+
+	// Upgrade part IDs fitted from the tuning shop, by slot (see parts.go)
+	Upgrades map[PartSlot]string `json:"upgrades,omitempty"`
+
+	// Crash damage (see damage.go)
+	Damage Damage `json:"damage"`
 }
 
 // NewCar creates a new car with default values
@@ -40,9 +48,74 @@ func NewCar(make, model string, year int, weight float64) *Car {
 		BrakingEfficiency: 0.6,
 		Brakes: Brakes{
 			Type:          "Standard",
-			Condition:     0.8,
+			Condition:     NewBrakeCondition,
 			Performance:   0.7,
 			StoppingPower: 0.6,
 		},
 	}
 }
+
+// Clone returns a copy of the car, so a profile's car can be driven and refuelled
+// without changing the shared catalogue entry it was picked from
+func (c *Car) Clone() *Car {
+	clone := *c
+	clone.Upgrades = maps.Clone(c.Upgrades)
+	return &clone
+}
+
+// TankCapacity returns how many litres the tank holds, including a larger tank if one is fitted
+func (c *Car) TankCapacity() float64 {
+	return c.EffectiveStats().FuelCapacity
+}
+
+// FuelFraction returns how full the tank is, from 0 (empty) to 1 (full).
+// FuelLevel and TankCapacity are both in litres.
+func (c *Car) FuelFraction() float64 {
+	capacity := c.TankCapacity()
+	if capacity <= 0 {
+		return 0
+	}
+	return c.FuelLevel / capacity
+}
+
+// FuelNeeded returns the litres it takes to fill the tank
+func (c *Car) FuelNeeded() float64 {
+	return max(0, c.TankCapacity()-c.FuelLevel)
+}
+
+// AddFuel puts up to litres into the tank and returns how much fitted
+func (c *Car) AddFuel(litres float64) float64 {
+	added := max(0, min(litres, c.FuelNeeded()))
+	c.FuelLevel += added
+	return added
+}
+
+// BurnFuel takes up to litres out of the tank and returns how much was actually burnt
+func (c *Car) BurnFuel(litres float64) float64 {
+	burnt := max(0, min(litres, c.FuelLevel))
+	c.FuelLevel -= burnt
+	return burnt
+}
+
+// CategoryHGV is the category of heavy goods vehicles: lorries driven for a haulier, never sold to the player
+const CategoryHGV = "HGV"
+
+// IsHGV reports whether the car is a heavy goods vehicle
+func (c *Car) IsHGV() bool {
+	return c.Category == CategoryHGV
+}
+
+// passengerSeats is how many passengers each category of car can carry besides the driver
+var passengerSeats = map[string]int{
+	"C1":        4,
+	"C2":        4,
+	"C3":        3,
+	"C4":        1,
+	"C5":        1,
+	CategoryHGV: 1,
+}
+
+// PassengerSeats returns how many passengers the car can carry
+func (c *Car) PassengerSeats() int {
+	return passengerSeats[c.Category]
+}
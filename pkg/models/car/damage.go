@@ -0,0 +1,62 @@
+package car
+
+import "math"
+
+// Damage is wear and crash damage to a car. Brake wear is kept in Brakes.Condition.
+type Damage struct {
+	Body      float64 `json:"body"`      // 0 (pristine) to 1 (wrecked); lowers resale value
+	Alignment float64 `json:"alignment"` // -1 to 1; the car pulls left when negative, right when positive
+	Engine    float64 `json:"engine"`    // 0 to 1; lowers power and top speed
+}
+
+// Brake condition limits
+const (
+	NewBrakeCondition  = 1.0
+	WornBrakeCondition = 0.2 // Brakes never wear past this
+)
+
+// Crash damage tuning, per MPH of impact
+const (
+	bodyDamagePerMPH      = 1.0 / 150
+	alignmentDamagePerMPH = 1.0 / 300
+	engineDamagePerMPH    = 1.0 / 250
+	engineDamageMinMPH    = 20.0 // Only harder front-end impacts reach the engine
+)
+
+// Damage effects at full damage
+const (
+	engineTopSpeedLoss = 0.4 // Fraction of top speed lost with a wrecked engine
+	enginePowerLoss    = 0.5 // Fraction of power lost with a wrecked engine
+)
+
+// Crash damages the car from an impact at impactMPH. frontal is true when the car hit something ahead of it,
+// and pull (-1 or 1) is the side the steering is knocked towards.
+func (c *Car) Crash(impactMPH float64, frontal bool, pull float64) {
+	c.Damage.Body = min(1, c.Damage.Body+impactMPH*bodyDamagePerMPH)
+	c.Damage.Alignment = max(-1, min(1, c.Damage.Alignment+pull*impactMPH*alignmentDamagePerMPH))
+	if frontal && impactMPH >= engineDamageMinMPH {
+		c.Damage.Engine = min(1, c.Damage.Engine+impactMPH*engineDamagePerMPH)
+	}
+}
+
+// WearBrakes wears the brakes by amount of condition
+func (c *Car) WearBrakes(amount float64) {
+	c.Brakes.Condition = max(WornBrakeCondition, c.Brakes.Condition-amount)
+}
+
+// Repair fixes all damage and fits new brake pads
+func (c *Car) Repair() {
+	c.Damage = Damage{}
+	c.Brakes.Condition = NewBrakeCondition
+}
+
+// NeedsRepair reports whether the car has any damage or brake wear
+func (c *Car) NeedsRepair() bool {
+	return c.Damage != Damage{} || c.Brakes.Condition < NewBrakeCondition
+}
+
+// DamageLevel returns the worst of the car's damage, 0 to 1, for display
+func (c *Car) DamageLevel() float64 {
+	brakeWear := (NewBrakeCondition - c.Brakes.Condition) / (NewBrakeCondition - WornBrakeCondition)
+	return max(c.Damage.Body, math.Abs(c.Damage.Alignment), c.Damage.Engine, brakeWear)
+}
@@ -0,0 +1,108 @@
+package car
+
+// PartSlot is where an upgrade part fits. A car takes one part per slot.
+type PartSlot string
+
+const (
+	SlotBrakes PartSlot = "brakes"
+	SlotEngine PartSlot = "engine"
+	SlotTank   PartSlot = "tank"
+	SlotTyres  PartSlot = "tyres"
+)
+
+// Part is an upgrade sold at the tuning shop
+type Part struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Slot  PartSlot `json:"slot"`
+	Price float64  `json:"price"`
+	Level int      `json:"level"` // Player level needed to buy it
+
+	// Effects, applied on top of the car's own figures
+	BHPMultiplier     float64 `json:"bhp_multiplier,omitempty"`      // Engine remaps; 1.15 is +15%
+	ExtraFuelCapacity float64 `json:"extra_fuel_capacity,omitempty"` // Litres
+	StoppingPower     float64 `json:"stopping_power,omitempty"`      // Added to Brakes.StoppingPower
+	Grip              float64 `json:"grip,omitempty"`                // Tyre grip multiplier
+}
+
+// Parts is the tuning shop catalogue, cheapest first within each slot
+var Parts = []Part{
+	{ID: "brakes_sport", Name: "Sport Brake Kit", Slot: SlotBrakes, Price: 800, Level: 2, StoppingPower: 0.10},
+	{ID: "brakes_race", Name: "Race Brake Kit", Slot: SlotBrakes, Price: 2500, Level: 8, StoppingPower: 0.25},
+	{ID: "remap_stage1", Name: "Stage 1 Remap", Slot: SlotEngine, Price: 1200, Level: 2, BHPMultiplier: 1.15},
+	{ID: "remap_stage2", Name: "Stage 2 Remap", Slot: SlotEngine, Price: 3500, Level: 8, BHPMultiplier: 1.30},
+	{ID: "tank_long_range", Name: "Long Range Tank", Slot: SlotTank, Price: 600, Level: 1, ExtraFuelCapacity: 20},
+	{ID: "tyres_sport", Name: "Sport Tyres", Slot: SlotTyres, Price: 700, Level: 3, Grip: 1.15},
+	{ID: "tyres_race", Name: "Race Tyres", Slot: SlotTyres, Price: 2000, Level: 10, Grip: 1.35},
+}
+
+// PartByID looks up a part in the catalogue
+func PartByID(id string) (Part, bool) {
+	for _, part := range Parts {
+		if part.ID == id {
+			return part, true
+		}
+	}
+	return Part{}, false
+}
+
+// Stats are a car's performance figures with its upgrades fitted and its damage taken into account
+type Stats struct {
+	BHP            float64
+	FuelCapacity   float64 // Litres
+	StoppingPower  float64 // 0.0 to 1.0, scaled by brake condition
+	Grip           float64 // Tyre grip multiplier; 1 on standard tyres
+	TopSpeedFactor float64 // Fraction of the speed limit the car can reach; 1 when undamaged
+	Pull           float64 // Sideways pull from bad alignment, -1 (left) to 1 (right)
+}
+
+// EffectiveStats returns the car's figures with its fitted parts and damage applied
+func (c *Car) EffectiveStats() Stats {
+	stats := Stats{
+		BHP:            float64(c.BHP),
+		FuelCapacity:   c.FuelCapacity,
+		StoppingPower:  c.Brakes.StoppingPower,
+		Grip:           1,
+		TopSpeedFactor: 1 - c.Damage.Engine*engineTopSpeedLoss,
+		Pull:           c.Damage.Alignment,
+	}
+
+	for _, part := range c.FittedParts() {
+		if part.BHPMultiplier > 0 {
+			stats.BHP *= part.BHPMultiplier
+		}
+		if part.Grip > 0 {
+			stats.Grip *= part.Grip
+		}
+		stats.FuelCapacity += part.ExtraFuelCapacity
+		stats.StoppingPower += part.StoppingPower
+	}
+	stats.StoppingPower = min(1, stats.StoppingPower) * c.Brakes.Condition
+	stats.BHP *= 1 - c.Damage.Engine*enginePowerLoss
+
+	return stats
+}
+
+// FittedParts returns the parts fitted to the car, in catalogue order
+func (c *Car) FittedParts() []Part {
+	fitted := make([]Part, 0, len(c.Upgrades))
+	for _, part := range Parts {
+		if c.Upgrades[part.Slot] == part.ID {
+			fitted = append(fitted, part)
+		}
+	}
+	return fitted
+}
+
+// Fit fits part to the car, replacing whatever was in its slot
+func (c *Car) Fit(part Part) {
+	if c.Upgrades == nil {
+		c.Upgrades = make(map[PartSlot]string)
+	}
+	c.Upgrades[part.Slot] = part.ID
+}
+
+// HasPart reports whether part is fitted
+func (c *Car) HasPart(part Part) bool {
+	return c.Upgrades[part.Slot] == part.ID
+}
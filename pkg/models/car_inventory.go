@@ -1,10 +1,8 @@
 package models
 
 import (
-	"encoding/json"
 	"log"
 	"math/rand"
-	"os"
 
 	"github.com/golangdaddy/roadster/pkg/models/car"
 )
@@ -37,19 +35,8 @@ type carInventory struct {
 	carsByCategory map[string][]*car.Car
 }
 
-// LoadInventory loads cars from the JSON file
-func (ci *carInventory) LoadInventory(filePath string) error {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	var carDataList []CarData
-	if err := json.NewDecoder(file).Decode(&carDataList); err != nil {
-		return err
-	}
-
+// LoadInventory builds the inventory from decoded car data (see assets.Manager.CarData)
+func (ci *carInventory) LoadInventory(carDataList []CarData) error {
 	ci.carData = carDataList
 	ci.cars = make([]*car.Car, 0, len(carDataList))
 	ci.carsByCategory = make(map[string][]*car.Car)
@@ -66,7 +53,10 @@ func (ci *carInventory) LoadInventory(filePath string) error {
 		c.BrakingEfficiency = data.BrakingEfficiency
 		c.Brakes.StoppingPower = data.BrakingEfficiency
 
-		ci.cars = append(ci.cars, c)
+		// HGVs are only driven for a haulier, so they are kept off the list of cars for sale
+		if !c.IsHGV() {
+			ci.cars = append(ci.cars, c)
+		}
 
 		// Group by category
 		if _, exists := ci.carsByCategory[c.Category]; !exists {
@@ -84,9 +74,9 @@ func (ci *carInventory) GetAllCars() []*car.Car {
 	return ci.cars
 }
 
-// GetRandomCarByCategory returns a random car from the specified categories
+// GetRandomCarByCategory returns a random car from the specified categories, picked with rng
 // categories: list of allowed category strings (e.g., "C1", "C2")
-func (ci *carInventory) GetRandomCarByCategory(allowedCategories []string) *car.Car {
+func (ci *carInventory) GetRandomCarByCategory(rng *rand.Rand, allowedCategories []string) *car.Car {
 	if len(ci.cars) == 0 {
 		// Should be covered by default init, but just in case
 		return car.NewCar("Default", "Car", 2022, 1200)
@@ -104,12 +94,12 @@ func (ci *carInventory) GetRandomCarByCategory(allowedCategories []string) *car.
 	if len(candidates) == 0 {
 		// Just pick from all cars if we have loaded them, otherwise fallback
 		if len(ci.cars) > 0 {
-			return ci.cars[rand.Intn(len(ci.cars))]
+			return ci.cars[rng.Intn(len(ci.cars))]
 		}
 		return car.NewCar("Fallback", "Car", 2022, 1200)
 	}
 
-	return candidates[rand.Intn(len(candidates))]
+	return candidates[rng.Intn(len(candidates))]
 }
 
 // GetRandomCarData returns a random CarData entry for traffic generation
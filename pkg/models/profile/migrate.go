@@ -0,0 +1,200 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CurrentVersion is the save format version written by this build
+const CurrentVersion = 10
+
+// migration upgrades a raw save document by exactly one version
+type migration func(doc map[string]any) error
+
+// migrations[i] upgrades a version i save to version i+1.
+// Add a new entry (and bump CurrentVersion) whenever PlayerProfile gains fields that need defaults.
+var migrations = []migration{
+	migrateV0ToV1,
+	migrateV1ToV2,
+	migrateV2ToV3,
+	migrateV3ToV4,
+	migrateV4ToV5,
+	migrateV5ToV6,
+	migrateV6ToV7,
+	migrateV7ToV8,
+	migrateV8ToV9,
+	migrateV9ToV10,
+}
+
+// Decode parses a save file of any known version and migrates it to CurrentVersion
+func Decode(data []byte) (*PlayerProfile, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	version := 0
+	if v, ok := doc["version"].(float64); ok {
+		version = int(v)
+	}
+	if version > CurrentVersion {
+		return nil, fmt.Errorf("save version %d is newer than this game (version %d)", version, CurrentVersion)
+	}
+
+	for v := version; v < CurrentVersion; v++ {
+		if err := migrations[v](doc); err != nil {
+			return nil, fmt.Errorf("migrating save from version %d: %w", v, err)
+		}
+		doc["version"] = v + 1
+	}
+
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var p PlayerProfile
+	if err := json.Unmarshal(migrated, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// setDefault fills a field that older saves do not have
+func setDefault(doc map[string]any, key string, value any) {
+	if _, ok := doc[key]; !ok {
+		doc[key] = value
+	}
+}
+
+// migrateV0ToV1 upgrades unversioned saves. These are either early PlayerProfile files,
+// or the old models.GameState save.json (name, player_name, current_level, score, created_at, updated_at).
+func migrateV0ToV1(doc map[string]any) error {
+	if playerName, ok := doc["player_name"]; ok {
+		// GameState used "name" for the save slot and "player_name" for the player
+		if saveName, ok := doc["name"].(string); ok && saveName != "" {
+			doc["id"] = saveName
+		}
+		doc["name"] = playerName
+		delete(doc, "player_name")
+
+		if created, ok := doc["created_at"]; ok {
+			doc["created"] = created
+			delete(doc, "created_at")
+		}
+		if updated, ok := doc["updated_at"]; ok {
+			doc["last_played"] = updated
+			delete(doc, "updated_at")
+		}
+	}
+
+	if _, ok := doc["id"].(string); !ok {
+		return fmt.Errorf("save has no id")
+	}
+
+	setDefault(doc, "level", 1)
+	setDefault(doc, "current_level", 0)
+	setDefault(doc, "score", 0)
+	setDefault(doc, "money", 1000.0)
+	setDefault(doc, "food_capacity", 100.0)
+	setDefault(doc, "food_level", 100.0)
+	return nil
+}
+
+// migrateV1ToV2 adds the lifetime counters and the sleep/toilet meters written back after each run
+func migrateV1ToV2(doc map[string]any) error {
+	setDefault(doc, "total_crashes", 0)
+	setDefault(doc, "sessions_played", 0)
+	setDefault(doc, "sleep_capacity", 100.0)
+	setDefault(doc, "sleep_level", 100.0)
+	setDefault(doc, "toilet_level", 0.0)
+	return nil
+}
+
+// migrateV2ToV3 adds the difficulty setting
+func migrateV2ToV3(doc map[string]any) error {
+	setDefault(doc, "difficulty", DifficultyNormal)
+	return nil
+}
+
+// migrateV3ToV4 replaces the single current_car with the owned cars collection.
+// The car the player was driving becomes their only owned car.
+func migrateV3ToV4(doc map[string]any) error {
+	currentCar, ok := doc["current_car"].(map[string]any)
+	delete(doc, "current_car")
+	if ok {
+		doc["owned_cars"] = []any{currentCar}
+		doc["current_car_index"] = 0
+		return nil
+	}
+
+	doc["owned_cars"] = []any{}
+	doc["current_car_index"] = -1
+	return nil
+}
+
+// migrateV4ToV5 adds XP. Older saves levelled on cars passed; the game lifts their XP
+// to the start of the level they had reached, so nobody loses a level.
+func migrateV4ToV5(doc map[string]any) error {
+	setDefault(doc, "xp", 0)
+	return nil
+}
+
+// migrateV5ToV6 adds the delivery count. Older saves have no contracts on the go.
+func migrateV5ToV6(doc map[string]any) error {
+	setDefault(doc, "deliveries_completed", 0)
+	return nil
+}
+
+// migrateV6ToV7 adds the taxi career, started with no trips
+func migrateV6ToV7(doc map[string]any) error {
+	setDefault(doc, "taxi_rating", 0.0)
+	setDefault(doc, "taxi_trips", 0)
+	return nil
+}
+
+// migrateV7ToV8 adds the driving licence, clean
+func migrateV7ToV8(doc map[string]any) error {
+	setDefault(doc, "licence", map[string]any{"points": 0, "suspended": false})
+	return nil
+}
+
+// migrateV8ToV9 adds the police chase record, with nothing stolen
+func migrateV8ToV9(doc map[string]any) error {
+	setDefault(doc, "cars_stolen", 0)
+	setDefault(doc, "escapes", 0)
+	setDefault(doc, "arrests", 0)
+	return nil
+}
+
+// catalogueBrakeCondition is the brake condition every car was built with before 1.0 meant new brakes
+const catalogueBrakeCondition = 0.8
+
+// migrateV9ToV10 fits new brakes to owned cars that are still as they came from the catalogue,
+// so undamaged cars no longer count as worn
+func migrateV9ToV10(doc map[string]any) error {
+	cars, _ := doc["owned_cars"].([]any)
+	for _, c := range cars {
+		saved, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		brakes, ok := saved["brakes"].(map[string]any)
+		if !ok || brakes["condition"] != catalogueBrakeCondition || damaged(saved["damage"]) {
+			continue
+		}
+		brakes["condition"] = 1.0
+	}
+	return nil
+}
+
+// damaged reports whether a saved car's damage has any non-zero field
+func damaged(damage any) bool {
+	fields, _ := damage.(map[string]any)
+	for _, v := range fields {
+		if v != 0.0 {
+			return true
+		}
+	}
+	return false
+}
@@ -3,45 +3,125 @@ package profile
 import (
 	"time"
 
+	"github.com/golangdaddy/roadster/pkg/contracts"
+	"github.com/golangdaddy/roadster/pkg/licence"
 	"github.com/golangdaddy/roadster/pkg/models/car"
 )
 
-// PlayerProfile represents a user's save game and identity
+// PlayerProfile represents a user's save game and identity.
+// It is the only save format; Version is bumped (with a migration in migrate.go) whenever fields change.
 type PlayerProfile struct {
+	Version      int       `json:"version"`
 	ID           string    `json:"id"`
 	Name         string    `json:"name"`
 	AvatarPath   string    `json:"avatar_path"`   // Path to full body sprite
 	HeadshotPath string    `json:"headshot_path"` // Path to profile image
 	Created      time.Time `json:"created"`
 	LastPlayed   time.Time `json:"last_played"`
-	
+
 	// Game Progress
 	Level             int     `json:"level"`
+	XP                int     `json:"xp"`            // Lifetime XP; Level follows from it
+	CurrentLevel      int     `json:"current_level"` // Index of the level file being played
+	Score             int     `json:"score"`
 	TotalCarsPassed   int     `json:"total_cars_passed"`
-	DistanceTravelled float64 `json:"distance_travelled"`
-	
+	DistanceTravelled float64 `json:"distance_travelled"` // Lifetime miles
+	TotalCrashes      int     `json:"total_crashes"`
+	SessionsPlayed    int     `json:"sessions_played"`
+
+	BestScores map[string]int `json:"best_scores,omitempty"` // Best score on each level, by level name
+
+	// Delivery contracts
+	Contracts           []contracts.Contract `json:"contracts,omitempty"` // Taken on and not yet delivered or failed
+	DeliveriesCompleted int                  `json:"deliveries_completed"`
+
+	// Taxi career
+	TaxiRating float64 `json:"taxi_rating"` // Average stars over every taxi trip
+	TaxiTrips  int     `json:"taxi_trips"`
+
+	// Penalty points and offences; a suspended licence locks the professional modes
+	Licence licence.Licence `json:"licence"`
+
+	// Police chases: cars stolen, chases got away from and arrests
+	CarsStolen int `json:"cars_stolen"`
+	Escapes    int `json:"escapes"`
+	Arrests    int `json:"arrests"`
+
 	// Current State
-	CurrentCar *car.Car `json:"current_car"`
-	Money      float64  `json:"money"`
-	
+	OwnedCars       []*car.Car `json:"owned_cars"`
+	CurrentCarIndex int        `json:"current_car_index"` // Index into OwnedCars of the car being driven; -1 for none
+	Money           float64    `json:"money"`
+	Difficulty      string     `json:"difficulty"` // One of the Difficulty constants
+
 	// Player Stats
-	FoodCapacity float64 `json:"food_capacity"` // 0-100 scale
-	FoodLevel    float64 `json:"food_level"`    // 0-100 scale
+	FoodCapacity  float64 `json:"food_capacity"`  // 0-100 scale
+	FoodLevel     float64 `json:"food_level"`     // 0-100 scale
+	SleepCapacity float64 `json:"sleep_capacity"` // 0-100 scale
+	SleepLevel    float64 `json:"sleep_level"`    // 0-100 scale
+	ToiletLevel   float64 `json:"toilet_level"`   // 0-100 scale
 }
 
+// Difficulty settings. They decide which crashes send the player back to the last checkpoint.
+const (
+	DifficultyEasy   = "easy"   // Every crash respawns at the checkpoint
+	DifficultyNormal = "normal" // Only severe crashes respawn
+	DifficultyHard   = "hard"   // No respawns; crashes only count towards game over
+)
+
+// Difficulties lists the difficulty settings in the order they are cycled through
+var Difficulties = []string{DifficultyEasy, DifficultyNormal, DifficultyHard}
+
 // NewProfile creates a new player profile
 func NewProfile(name, avatarPath, headshotPath string) *PlayerProfile {
 	return &PlayerProfile{
-		ID:           name + "_" + time.Now().Format("20060102150405"),
-		Name:         name,
-		AvatarPath:   avatarPath,
-		HeadshotPath: headshotPath,
-		Created:      time.Now(),
-		LastPlayed:   time.Now(),
-		Level:        1,
-		Money:        1000.0, // Starting money
-		FoodCapacity: 100.0,
-		FoodLevel:    100.0, // Start full
+		Version:         CurrentVersion,
+		ID:              name + "_" + time.Now().Format("20060102150405"),
+		Name:            name,
+		AvatarPath:      avatarPath,
+		HeadshotPath:    headshotPath,
+		Created:         time.Now(),
+		LastPlayed:      time.Now(),
+		Level:           1,
+		CurrentCarIndex: -1,     // The game hands out a starter car
+		Money:           1000.0, // Starting money
+		Difficulty:      DifficultyNormal,
+		FoodCapacity:    100.0,
+		FoodLevel:       100.0, // Start full
+		SleepCapacity:   100.0,
+		SleepLevel:      100.0, // Start well-rested
 	}
 }
 
+// CurrentCar returns the owned car being driven, or nil if none is selected
+func (p *PlayerProfile) CurrentCar() *car.Car {
+	if p.CurrentCarIndex < 0 || p.CurrentCarIndex >= len(p.OwnedCars) {
+		return nil
+	}
+	return p.OwnedCars[p.CurrentCarIndex]
+}
+
+// AddCar adds c to the owned cars and returns its index
+func (p *PlayerProfile) AddCar(c *car.Car) int {
+	p.OwnedCars = append(p.OwnedCars, c)
+	return len(p.OwnedCars) - 1
+}
+
+// RemoveCar takes the car at index out of the owned cars. If it was being driven, the first remaining car
+// is selected instead.
+func (p *PlayerProfile) RemoveCar(index int) *car.Car {
+	if index < 0 || index >= len(p.OwnedCars) {
+		return nil
+	}
+	removed := p.OwnedCars[index]
+	p.OwnedCars = append(p.OwnedCars[:index], p.OwnedCars[index+1:]...)
+
+	switch {
+	case p.CurrentCarIndex == index && len(p.OwnedCars) > 0:
+		p.CurrentCarIndex = 0
+	case p.CurrentCarIndex == index:
+		p.CurrentCarIndex = -1
+	case p.CurrentCarIndex > index:
+		p.CurrentCarIndex--
+	}
+	return removed
+}
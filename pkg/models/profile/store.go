@@ -0,0 +1,214 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// profileExt is the file extension of saved profiles
+const profileExt = ".json"
+
+// Subdirectories of the store for files that are not profiles
+const (
+	snapshotDir = "snapshots" // Mid-level saves, one per profile
+	crashDir    = "crashes"   // Gameplay state dumped when the game panics
+	ghostDir    = "ghosts"    // Recorded time trial runs, played back as ghost cars
+)
+
+// Store persists each profile as its own JSON file in a directory
+type Store struct {
+	dir string
+}
+
+// DefaultDir returns the per-user directory profiles are saved in
+func DefaultDir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "roadster", "profiles"), nil
+}
+
+// NewStore creates a store in dir, creating the directory if needed
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Dir returns the directory the store writes to
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// path returns the file a profile ID is stored in
+func (s *Store) path(id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return "", fmt.Errorf("invalid profile id %q", id)
+	}
+	return filepath.Join(s.dir, id+profileExt), nil
+}
+
+// Save writes the profile to disk and stamps it as last played now
+func (s *Store) Save(p *PlayerProfile) error {
+	path, err := s.path(p.ID)
+	if err != nil {
+		return err
+	}
+
+	p.LastPlayed = time.Now()
+	p.Version = CurrentVersion
+
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(path, data)
+}
+
+// Load reads a single profile by ID
+func (s *Store) Load(id string) (*PlayerProfile, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return nil, err
+	}
+	return loadFile(path)
+}
+
+// List returns every saved profile, most recently played first.
+// Unreadable files are logged and skipped so one bad save does not hide the rest.
+func (s *Store) List() ([]*PlayerProfile, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, "*"+profileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*PlayerProfile, 0, len(files))
+	for _, file := range files {
+		p, err := loadFile(file)
+		if err != nil {
+			log.Printf("Skipping profile %s: %v", file, err)
+			continue
+		}
+		profiles = append(profiles, p)
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].LastPlayed.After(profiles[j].LastPlayed)
+	})
+	return profiles, nil
+}
+
+// Delete removes a saved profile
+func (s *Store) Delete(id string) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Import copies a save file from outside the store (e.g. a legacy save.json) into the store.
+// Saves that already exist in the store are left untouched and reported as not imported.
+func (s *Store) Import(path string) (p *PlayerProfile, imported bool, err error) {
+	p, err = loadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if existing, err := s.Load(p.ID); err == nil {
+		return existing, false, nil
+	}
+	if err := s.Save(p); err != nil {
+		return nil, false, err
+	}
+	return p, true, nil
+}
+
+// SnapshotPath returns the file a profile's mid-level save is kept in, creating its directory if needed.
+// The store only manages the location; the snapshot format belongs to the game.
+func (s *Store) SnapshotPath(id string) (string, error) {
+	path, err := s.path(id)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(s.dir, snapshotDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, filepath.Base(path)), nil
+}
+
+// CrashDir returns the directory crash-repro snapshots are written to, creating it if needed
+func (s *Store) CrashDir() (string, error) {
+	dir := filepath.Join(s.dir, crashDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// GhostPath returns the file the named time trial recording is kept in, creating its directory if needed.
+// Recordings are shared by every profile, like the leaderboard that refers to them.
+func (s *Store) GhostPath(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid ghost name %q", name)
+	}
+	dir := filepath.Join(s.dir, ghostDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// loadFile decodes a profile file, migrating older save versions
+func loadFile(path string) (*PlayerProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// WriteFileAtomic writes data to a temporary file next to path and renames it into place,
+// so a crash mid-write never leaves a truncated file behind
+func WriteFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmpName)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+
+	if err := os.Rename(tmpName, path); err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Package mods discovers asset packs in the mods/ folder and mounts them over the base assets.
+//
+// A pack is a directory containing a mod.json manifest plus any of:
+//
+//	car_data.json   cars to add, or to replace by "id"
+//	level/*.json    new (or replacement) level files
+//	road/<X>.png    road tiles; a new letter X can then be used in level segments
+//
+// Packs are applied in ascending load_order (ties broken by name). Later packs win.
+package mods
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+)
+
+// ManifestFile is the manifest every pack must contain
+const ManifestFile = "mod.json"
+
+// Manifest describes a pack
+type Manifest struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	LoadOrder   int    `json:"load_order"`
+	Author      string `json:"author"`
+	Description string `json:"description"`
+}
+
+// Pack is a mod found on disk
+type Pack struct {
+	Manifest
+	Dir string
+	FS  fs.FS
+}
+
+// Conflict records an asset that more than one pack provides
+type Conflict struct {
+	Asset string   // Asset path, or "car #<id>" for car data entries
+	Packs []string // Pack names in load order; the last one wins
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s is provided by %s (using %s)", c.Asset, strings.Join(c.Packs, ", "), c.Packs[len(c.Packs)-1])
+}
+
+// Scan reads every pack in dir, sorted by load order.
+// A missing mods directory is not an error; packs with a bad manifest or car data are skipped.
+func Scan(dir string) ([]*Pack, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	packs := make([]*Pack, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		packDir := filepath.Join(dir, entry.Name())
+		pack, err := loadPack(packDir)
+		if err != nil {
+			log.Printf("Skipping mod %s: %v", packDir, err)
+			continue
+		}
+		packs = append(packs, pack)
+	}
+
+	sort.SliceStable(packs, func(i, j int) bool {
+		if packs[i].LoadOrder != packs[j].LoadOrder {
+			return packs[i].LoadOrder < packs[j].LoadOrder
+		}
+		return packs[i].Name < packs[j].Name
+	})
+
+	return packs, nil
+}
+
+// loadPack reads and validates a pack's manifest and car data
+func loadPack(dir string) (*Pack, error) {
+	fsys := os.DirFS(dir)
+
+	data, err := fs.ReadFile(fsys, ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%s: %w", ManifestFile, err)
+	}
+	if manifest.Name == "" {
+		return nil, fmt.Errorf("%s: missing name", ManifestFile)
+	}
+	if manifest.Version == "" {
+		return nil, fmt.Errorf("%s: missing version", ManifestFile)
+	}
+
+	data, err = fs.ReadFile(fsys, assets.CarDataPath)
+	if err == nil {
+		_, err = assets.ParseCarData(data)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", assets.CarDataPath, err)
+	}
+
+	return &Pack{
+		Manifest: manifest,
+		Dir:      dir,
+		FS:       fsys,
+	}, nil
+}
+
+// FindConflicts lists assets and car IDs provided by more than one pack, and packs sharing a name.
+// A pack that can't be read is left out of the check rather than failing it.
+func FindConflicts(packs []*Pack) []Conflict {
+	providers := make(map[string][]string)
+	order := make([]string, 0)
+
+	provide := func(asset, packName string) {
+		if _, seen := providers[asset]; !seen {
+			order = append(order, asset)
+		}
+		providers[asset] = append(providers[asset], packName)
+	}
+
+	for _, pack := range packs {
+		provide("pack "+pack.Name, pack.Name+" "+pack.Version)
+
+		provided := make([]string, 0)
+		err := fs.WalkDir(pack.FS, ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || path == ManifestFile {
+				return nil
+			}
+
+			if path != assets.CarDataPath {
+				provided = append(provided, path)
+				return nil
+			}
+
+			data, err := fs.ReadFile(pack.FS, path)
+			if err != nil {
+				return err
+			}
+			cars, err := assets.ParseCarData(data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			for _, c := range cars {
+				provided = append(provided, fmt.Sprintf("car #%d", c.ID))
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Skipping conflict check for mod %s: %v", pack.Name, err)
+			continue
+		}
+		for _, asset := range provided {
+			provide(asset, pack.Name)
+		}
+	}
+
+	conflicts := make([]Conflict, 0)
+	for _, asset := range order {
+		if len(providers[asset]) > 1 {
+			conflicts = append(conflicts, Conflict{Asset: asset, Packs: providers[asset]})
+		}
+	}
+	return conflicts
+}
+
+// Load scans dir, reports conflicts and mounts every pack on the asset manager in load order
+func Load(manager *assets.Manager, dir string) ([]*Pack, error) {
+	packs, err := Scan(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conflict := range FindConflicts(packs) {
+		log.Printf("Mod conflict: %s", conflict)
+	}
+
+	for _, pack := range packs {
+		manager.Mount(pack.Name, pack.FS)
+		log.Printf("Loaded mod %s %s (load order %d)", pack.Name, pack.Version, pack.LoadOrder)
+	}
+
+	return packs, nil
+}
@@ -0,0 +1,49 @@
+package progression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/models/car"
+)
+
+// categoryLevels is the player level each car category is sold from. Categories not listed are always on sale.
+var categoryLevels = map[string]int{
+	"C1": 1,
+	"C2": 3,
+	"C3": 6,
+	"C4": 10,
+	"C5": 15,
+}
+
+// CarLevel returns the player level needed to buy c
+func CarLevel(c *car.Car) int {
+	return max(1, categoryLevels[c.Category])
+}
+
+// PartLevel returns the player level needed to buy part
+func PartLevel(part car.Part) int {
+	return max(1, part.Level)
+}
+
+// Unlocked reports whether something needing required is available at level.
+// With levelling disabled nothing is locked.
+func Unlocked(required, level int) bool {
+	return !Curve().Enabled || level >= required
+}
+
+// UnlocksAt describes the cars and parts that become available on reaching level
+func UnlocksAt(level int) []string {
+	var unlocks []string
+	for _, category := range []string{"C1", "C2", "C3", "C4", "C5"} {
+		if categoryLevels[category] == level {
+			unlocks = append(unlocks, fmt.Sprintf("%s CARS", category))
+		}
+	}
+	for _, part := range car.Parts {
+		if part.Level == level {
+			unlocks = append(unlocks, strings.ToUpper(part.Name))
+		}
+	}
+	return unlocks
+}
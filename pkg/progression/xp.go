@@ -0,0 +1,16 @@
+package progression
+
+// XP awards. Overtakes earn Leveling.BaseXPPerCar; the rest are fixed.
+const (
+	XPPerCleanMile   = 5   // Each mile driven since the last crash
+	XPPerNearMiss    = 15  // Passing close to another car without touching it
+	XPPerDelivery    = 100 // Delivering a contract on time
+	XPPerServiceStop = 20  // Stopping at a service and using it
+	XPCrashPenalty   = 50  // Taken for each counted crash
+	XPArrestPenalty  = 250 // Taken when the police arrest the player
+)
+
+// OvertakeXP returns the XP for overtaking a car
+func OvertakeXP() int {
+	return Curve().BaseXPPerCar
+}
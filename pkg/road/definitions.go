@@ -17,8 +17,10 @@ const (
 
 // this should be the new contents of files in assets/level/*.level
 type LevelDefinition struct {
-	Laybys   []*Layby            `json:"laybys"`
-	Sections map[string]*Section `json:"sections"`
+	RequiredLevel int                 `json:"required_level,omitempty"` // Player level needed to drive it; 0 or 1 for none
+	Laybys        []*Layby            `json:"laybys"`
+	SpeedCameras  []*SpeedCamera      `json:"speed_cameras,omitempty"`
+	Sections      map[string]*Section `json:"sections"`
 	// the layout is a list of section names in the order they should be placed in the level baed on their key in the map above.
 	Layout []string `json:"layout"`
 }
@@ -36,6 +38,12 @@ type Layby struct {
 	ExitDestination string     `json:"exit_destination"`
 }
 
+// a speed camera gantry across the whole carriageway, at the start of a segment. it catches anyone
+// going too far over their lane's speed limit.
+type SpeedCamera struct {
+	Segment int `json:"segment"`
+}
+
 type Service struct {
 	Type     int `json:"type"`
 	Position int
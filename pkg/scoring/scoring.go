@@ -0,0 +1,74 @@
+// Package scoring keeps the score of a run: overtakes and near misses, built up by combos and a speed multiplier
+package scoring
+
+// Points for each scoring move, before the combo bonus and multiplier
+const (
+	OvertakePoints = 10
+	NearMissPoints = 50
+)
+
+// Combo and multiplier tuning
+const (
+	ComboBonus          = 0.1  // Extra share of the base points per overtake in the current combo
+	MaxCombo            = 50   // The combo bonus stops growing here
+	MaxMultiplier       = 3.0  // Highest speed multiplier
+	MultiplierPerSecond = 0.05 // Multiplier gained per second driven at the lane speed limit
+	SpeedLimitTolerance = 5.0  // MPH under the limit that still counts as driving at it
+	ticksPerSecond      = 60.0 // Game ticks per second, to turn MultiplierPerSecond into a per-tick step
+)
+
+// Engine scores one run. It is fed events by the game and knows nothing about the road itself.
+type Engine struct {
+	Score      int     `json:"score"`
+	Combo      int     `json:"combo"`      // Overtakes in a row without braking or crashing
+	BestCombo  int     `json:"best_combo"` // Longest combo this run
+	Multiplier float64 `json:"multiplier"` // Speed multiplier, 1 to MaxMultiplier
+	NearMisses int     `json:"near_misses"`
+}
+
+// NewEngine creates an engine with nothing scored
+func NewEngine() *Engine {
+	return &Engine{Multiplier: 1}
+}
+
+// award adds base points with the combo bonus and multiplier applied, and returns what was added
+func (e *Engine) award(base int) int {
+	bonus := 1 + ComboBonus*float64(min(e.Combo, MaxCombo))
+	points := int(float64(base)*bonus*e.Multiplier + 0.5)
+	e.Score += points
+	return points
+}
+
+// Overtake scores passing a car and extends the combo
+func (e *Engine) Overtake() int {
+	e.Combo++
+	e.BestCombo = max(e.BestCombo, e.Combo)
+	return e.award(OvertakePoints)
+}
+
+// NearMiss scores passing close to a car without touching it
+func (e *Engine) NearMiss() int {
+	e.NearMisses++
+	return e.award(NearMissPoints)
+}
+
+// Brake ends the combo
+func (e *Engine) Brake() {
+	e.Combo = 0
+}
+
+// Crash ends the combo and loses the speed multiplier
+func (e *Engine) Crash() {
+	e.Combo = 0
+	e.Multiplier = 1
+}
+
+// UpdateSpeed is called every tick. Driving at the lane speed limit builds the multiplier;
+// dropping below it, or speeding, resets it.
+func (e *Engine) UpdateSpeed(speedMPH, limitMPH float64) {
+	if speedMPH >= limitMPH-SpeedLimitTolerance && speedMPH <= limitMPH+0.5 {
+		e.Multiplier = min(MaxMultiplier, e.Multiplier+MultiplierPerSecond/ticksPerSecond)
+		return
+	}
+	e.Multiplier = 1
+}
@@ -0,0 +1,68 @@
+// Package tachograph records an HGV driver's driving and break time, and spots when they drive for longer
+// than the law allows without a break
+package tachograph
+
+import "fmt"
+
+// The tachograph runs on a compressed clock so the legal limits fit a level
+const (
+	TicksPerHour = 2000                 // Game ticks per tachograph hour (a little over half a minute)
+	DrivingLimit = TicksPerHour * 9 / 2 // Continuous driving allowed before a break: 4h30
+	BreakLength  = TicksPerHour * 3 / 4 // Break that resets the driving time: 45 minutes
+	WarningTime  = TicksPerHour / 2     // Driving time left at which the HUD warns of a break due
+	Fine         = 300.0                // Per infringement: going over the limit, and every hour after
+	penaltyEvery = TicksPerHour         // Further driving over the limit before the next fine
+)
+
+// Event is something the tachograph has recorded that the driver should hear about
+type Event int
+
+const (
+	None         Event = iota
+	BreakTaken         // A full break has reset the driving time
+	Infringement       // The driver has gone over the limit, or another hour over it
+)
+
+// Tachograph is a driver's record since their last full break
+type Tachograph struct {
+	Driving       int64 `json:"driving"`       // Ticks driven since the last full break
+	Resting       int64 `json:"resting"`       // Ticks of the break in progress
+	Infringements int   `json:"infringements"` // Fines incurred
+}
+
+// Drive records a tick of driving. Any break in progress is lost.
+func (t *Tachograph) Drive() Event {
+	t.Resting = 0
+	t.Driving++
+
+	over := t.Driving - DrivingLimit
+	if over > 0 && (over-1)%penaltyEvery == 0 {
+		t.Infringements++
+		return Infringement
+	}
+	return None
+}
+
+// Rest records a tick of resting where breaks are allowed
+func (t *Tachograph) Rest() Event {
+	if t.Driving == 0 {
+		return None
+	}
+	t.Resting++
+	if t.Resting < BreakLength {
+		return None
+	}
+	t.Driving, t.Resting = 0, 0
+	return BreakTaken
+}
+
+// Remaining returns the driving time left before a break is due; negative once over the limit
+func (t *Tachograph) Remaining() int64 {
+	return DrivingLimit - t.Driving
+}
+
+// Format shows ticks on the tachograph clock as h:mm
+func Format(ticks int64) string {
+	minutes := max(0, ticks) * 60 / TicksPerHour
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
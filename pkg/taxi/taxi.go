@@ -0,0 +1,141 @@
+// Package taxi meters taxi fares, rates how smoothly each customer was driven and decides which cab
+// the taxi company trusts the player with
+package taxi
+
+import "math"
+
+// Fares
+const (
+	FlagFall              = 5.00  // Charged as the customer gets in
+	PricePerMile          = 40.00 // Of distance driven with the customer aboard
+	PricePerMinuteWaiting = 2.00  // Of time spent crawling or stopped with the customer aboard
+	waitingMPH            = 5.0   // Slower than this, the meter charges for waiting instead of distance
+	ticksPerMinute        = 3600
+)
+
+// Ratings, in stars
+const (
+	MaxRating = 5.0
+	MinRating = 1.0
+
+	accelJerkTolerance = 0.05 // Change in acceleration per tick (px/frame²) a customer does not notice
+	steerJerkTolerance = 0.02 // Change in steering rate per tick a customer does not notice
+	accelJerkWeight    = 10.0
+	steerJerkWeight    = 25.0
+	discomfortPenalty  = 0.2 // Stars lost per point of discomfort
+	collisionPenalty   = 1.5 // Stars lost per collision
+)
+
+// Trip is one customer's ride, metered from the moment they get in
+type Trip struct {
+	Customer        string  `json:"customer"`
+	Destination     int     `json:"destination"`      // Segment index of the layby they are dropped at
+	DestinationName string  `json:"destination_name"` // As told to the player, e.g. "EXIT TO 2"
+	StartMiles      float64 `json:"start_miles"`      // Trip meter when the customer got in
+	WaitingTicks    int64   `json:"waiting_ticks"`
+	WaitingMiles    float64 `json:"waiting_miles"` // Miles crawled while the meter charged for waiting
+	Discomfort      float64 `json:"discomfort"`    // Built up by jerky braking and steering
+	Collisions      int     `json:"collisions"`
+
+	// Last tick's motion, to measure jerk from
+	LastVelocity     float64 `json:"last_velocity"`
+	LastAccel        float64 `json:"last_accel"`
+	LastSteering     float64 `json:"last_steering"`
+	LastSteeringRate float64 `json:"last_steering_rate"`
+}
+
+// NewTrip starts the meter for a customer going to a layby, with the trip meter at miles and the car moving at velocity
+func NewTrip(customer, destinationName string, destination int, miles, velocity, steering float64) *Trip {
+	return &Trip{
+		Customer:        customer,
+		Destination:     destination,
+		DestinationName: destinationName,
+		StartMiles:      miles,
+		LastVelocity:    velocity,
+		LastSteering:    steering,
+	}
+}
+
+// Update is called every tick with the customer aboard. velocity is in px/frame and steering -1 to 1;
+// sharp changes in how either is changing are felt as discomfort.
+func (t *Trip) Update(speedMPH, milesThisTick, velocity, steering float64) {
+	if speedMPH < waitingMPH {
+		t.WaitingTicks++
+		t.WaitingMiles += milesThisTick
+	}
+
+	accel := velocity - t.LastVelocity
+	accelJerk := math.Abs(accel - t.LastAccel)
+	steeringRate := steering - t.LastSteering
+	steerJerk := math.Abs(steeringRate - t.LastSteeringRate)
+
+	t.Discomfort += max(0, accelJerk-accelJerkTolerance)*accelJerkWeight + max(0, steerJerk-steerJerkTolerance)*steerJerkWeight
+
+	t.LastVelocity, t.LastAccel = velocity, accel
+	t.LastSteering, t.LastSteeringRate = steering, steeringRate
+}
+
+// Collision counts a crash with the customer aboard
+func (t *Trip) Collision() {
+	t.Collisions++
+}
+
+// Fare returns what the meter shows with the trip meter at miles
+func (t *Trip) Fare(miles float64) float64 {
+	driven := max(0, miles-t.StartMiles-t.WaitingMiles)
+	waiting := float64(t.WaitingTicks) / ticksPerMinute
+	return roundCents(FlagFall + driven*PricePerMile + waiting*PricePerMinuteWaiting)
+}
+
+// Rating returns the stars the customer would give now
+func (t *Trip) Rating() float64 {
+	rating := MaxRating - t.Discomfort*discomfortPenalty - float64(t.Collisions)*collisionPenalty
+	return max(MinRating, min(MaxRating, rating))
+}
+
+// AverageRating adds rating to an average taken over that many earlier trips
+func AverageRating(average float64, trips int, rating float64) float64 {
+	return (average*float64(trips) + rating) / float64(trips+1)
+}
+
+// Tier is a class of cab the company hands out once the driver's rating and experience are good enough
+type Tier struct {
+	Category  string // Car category (C1-C5)
+	MinRating float64
+	MinTrips  int
+}
+
+// Fleet lists the tiers, worst cab first
+var Fleet = []Tier{
+	{Category: "C1"},
+	{Category: "C2", MinRating: 3.5, MinTrips: 5},
+	{Category: "C3", MinRating: 4.0, MinTrips: 15},
+	{Category: "C4", MinRating: 4.5, MinTrips: 30},
+}
+
+// CabTier returns the best tier a driver with this average rating and number of trips has earned
+func CabTier(rating float64, trips int) Tier {
+	best := Fleet[0]
+	for _, tier := range Fleet {
+		if rating >= tier.MinRating && trips >= tier.MinTrips {
+			best = tier
+		}
+	}
+	return best
+}
+
+// NextTier returns the tier after the driver's current one, or false if they already have the best cab
+func NextTier(rating float64, trips int) (Tier, bool) {
+	current := CabTier(rating, trips)
+	for i, tier := range Fleet {
+		if tier == current && i+1 < len(Fleet) {
+			return Fleet[i+1], true
+		}
+	}
+	return Tier{}, false
+}
+
+// roundCents rounds an amount to whole cents
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
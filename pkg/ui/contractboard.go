@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/contracts"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// ContractBoardScreen is the shop's board of delivery contracts, shown over the road while the player is stopped
+type ContractBoardScreen struct {
+	offers   []contracts.Contract
+	active   int // Contracts the player already carries
+	onAccept func(c contracts.Contract) bool
+	onExit   func()
+
+	selected int
+	message  string // Shown when a contract cannot be taken
+}
+
+// NewContractBoardScreen creates the board. onAccept is asked to take on a contract and reports whether it did;
+// onExit closes the board.
+func NewContractBoardScreen(offers []contracts.Contract, active int, onAccept func(c contracts.Contract) bool, onExit func()) *ContractBoardScreen {
+	return &ContractBoardScreen{
+		offers:   offers,
+		active:   active,
+		onAccept: onAccept,
+		onExit:   onExit,
+	}
+}
+
+// Update handles input for the contract board
+func (cb *ContractBoardScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || len(cb.offers) == 0 && inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		if cb.onExit != nil {
+			cb.onExit()
+		}
+		return nil
+	}
+	if len(cb.offers) == 0 {
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		cb.selected = (cb.selected + len(cb.offers) - 1) % len(cb.offers)
+		cb.message = ""
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		cb.selected = (cb.selected + 1) % len(cb.offers)
+		cb.message = ""
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		if cb.active >= contracts.MaxActive {
+			cb.message = fmt.Sprintf("YOU CAN ONLY CARRY %d CONTRACTS", contracts.MaxActive)
+			return nil
+		}
+		if cb.onAccept == nil || !cb.onAccept(cb.offers[cb.selected]) {
+			cb.message = "CONTRACT NOT AVAILABLE"
+			return nil
+		}
+		cb.active++
+		cb.offers = append(cb.offers[:cb.selected], cb.offers[cb.selected+1:]...)
+		cb.selected = max(0, min(cb.selected, len(cb.offers)-1))
+	}
+
+	return nil
+}
+
+// Draw renders the board over whatever is already on screen
+func (cb *ContractBoardScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+
+	// Dim the road behind the panel
+	shade := ebiten.NewImage(width, height)
+	shade.Fill(color.RGBA{0, 0, 0, 150})
+	screen.DrawImage(shade, nil)
+
+	panelWidth, panelHeight := 640.0, 340.0
+	panelX := float64(width)/2 - panelWidth/2
+	panelY := float64(height)/2 - panelHeight/2
+	panel := ebiten.NewImage(int(panelWidth), int(panelHeight))
+	panel.Fill(color.RGBA{40, 40, 50, 240})
+	panelOp := &ebiten.DrawImageOptions{}
+	panelOp.GeoM.Translate(panelX, panelY)
+	screen.DrawImage(panel, panelOp)
+
+	face := text.NewGoXFace(bitmapfont.Face)
+
+	// Title
+	titleColor := color.RGBA{255, 200, 0, 255}
+	titleText := "CONTRACTS BOARD"
+	titleSize := 32.0
+	titleWidth := text.Advance(titleText, face) * (titleSize / 16.0)
+	drawTextAt(screen, titleText, float64(width)/2-titleWidth/2, panelY+35, titleSize, titleColor, face)
+
+	textColor := color.RGBA{200, 200, 200, 255}
+	selectedColor := color.RGBA{200, 240, 255, 255}
+	instructionColor := color.RGBA{150, 150, 200, 255}
+	lineHeight := 50.0
+	startX := panelX + 30
+	currentY := panelY + 80
+
+	drawTextAt(screen, fmt.Sprintf("CARRYING %d / %d", cb.active, contracts.MaxActive), startX, currentY, 16, textColor, face)
+	currentY += lineHeight * 0.75
+
+	if len(cb.offers) == 0 {
+		drawTextAt(screen, "No deliveries from here today", startX, currentY, 18, textColor, face)
+		drawTextAt(screen, "Press ENTER or ESCAPE to drive on", startX, panelY+panelHeight-30, 14, instructionColor, face)
+		return
+	}
+
+	for i, offer := range cb.offers {
+		clr := textColor
+		prefix := "  "
+		if i == cb.selected {
+			clr = selectedColor
+			prefix = "> "
+		}
+		drawTextAt(screen, prefix+offer.Title(), startX, currentY, 18, clr, face)
+		terms := fmt.Sprintf("%s TO DELIVER | $%.0f | %d XP", offer.Countdown(), offer.Reward, offer.XP)
+		drawTextAt(screen, "  "+terms, startX, currentY+20, 14, clr, face)
+		currentY += lineHeight
+	}
+
+	if cb.message != "" {
+		drawTextAt(screen, cb.message, startX, currentY+5, 16, color.RGBA{255, 100, 100, 255}, face)
+	}
+	drawTextAt(screen, "Arrows: Choose | Enter: Accept | Esc: Leave", startX, panelY+panelHeight-30, 14, instructionColor, face)
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/economy"
+	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// Dealership tabs
+const (
+	dealershipBuy = iota
+	dealershipSell
+)
+
+// DealershipScreen sells catalogue cars to the player and buys their own cars back.
+// It reads the profile for the balance and owned cars; the transactions themselves are done by the callbacks.
+type DealershipScreen struct {
+	profile  *profile.PlayerProfile
+	tab      int
+	selected [2]int // Selected row on each tab
+	message  string // Result of the last buy or sell
+	failed   bool   // Whether message is an error
+
+	onBuy  func(c *car.Car) error // Buy a catalogue car
+	onSell func(index int) error  // Sell the owned car at index
+	onBack func()
+}
+
+// NewDealershipScreen creates a dealership for p
+func NewDealershipScreen(p *profile.PlayerProfile, onBuy func(c *car.Car) error, onSell func(index int) error, onBack func()) *DealershipScreen {
+	return &DealershipScreen{
+		profile: p,
+		onBuy:   onBuy,
+		onSell:  onSell,
+		onBack:  onBack,
+	}
+}
+
+// rows returns the cars listed on the current tab
+func (ds *DealershipScreen) rows() []*car.Car {
+	if ds.tab == dealershipSell {
+		return ds.profile.OwnedCars
+	}
+	return models.CarInventory.GetAllCars()
+}
+
+// Update handles input for the dealership screen
+func (ds *DealershipScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if ds.onBack != nil {
+			ds.onBack()
+		}
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) || inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		ds.tab = 1 - ds.tab
+		ds.message = ""
+	}
+
+	rows := ds.rows()
+	if len(rows) == 0 {
+		return nil
+	}
+
+	selected := &ds.selected[ds.tab]
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		*selected = (*selected + len(rows) - 1) % len(rows)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		*selected = (*selected + 1) % len(rows)
+	}
+	*selected = min(*selected, len(rows)-1)
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		c := rows[*selected]
+		var err error
+		if ds.tab == dealershipBuy {
+			if ds.onBuy != nil {
+				err = ds.onBuy(c)
+			}
+			ds.message = fmt.Sprintf("BOUGHT %s %s", c.Make, c.Model)
+		} else {
+			if ds.onSell != nil {
+				err = ds.onSell(*selected)
+			}
+			ds.message = fmt.Sprintf("SOLD %s %s", c.Make, c.Model)
+			*selected = max(0, min(*selected, len(ds.profile.OwnedCars)-1))
+		}
+
+		ds.failed = err != nil
+		if err != nil {
+			ds.message = err.Error()
+		}
+	}
+
+	return nil
+}
+
+// Draw renders the dealership screen
+func (ds *DealershipScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+	screen.Fill(color.RGBA{20, 20, 30, 255})
+
+	centerX := float64(width) / 2
+	drawText(screen, "CAR DEALERSHIP", centerX, 50, 40, color.RGBA{255, 200, 50, 255})
+	drawText(screen, fmt.Sprintf("LEVEL %d | MONEY: $%.2f", ds.profile.Level, ds.profile.Money), centerX, 95, 18, color.RGBA{100, 255, 100, 255})
+
+	// Tabs
+	tabWidth, tabHeight := 200.0, 36.0
+	for tab, label := range []string{"BUY", "SELL"} {
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{150, 150, 150, 255}
+		if tab == ds.tab {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		tabX := centerX - tabWidth - 10 + float64(tab)*(tabWidth+20)
+		drawButton(screen, label, tabX, 115, tabWidth, tabHeight, bgColor, textColor)
+	}
+
+	rows := ds.rows()
+	if len(rows) == 0 {
+		drawText(screen, "No cars", centerX, float64(height)/2, 24, color.RGBA{255, 255, 255, 255})
+	}
+
+	// Car list
+	startY := 170.0
+	rowSpacing := 60.0
+	buttonWidth := 760.0
+	buttonHeight := 50.0
+	buttonX := centerX - buttonWidth/2
+
+	selected := ds.selected[ds.tab]
+	first, last := visibleRows(selected, len(rows), garageVisibleRows)
+	for i := first; i < last; i++ {
+		c := rows[i]
+		price := economy.CarPrice(c)
+		if ds.tab == dealershipSell {
+			price = economy.CarResalePrice(c)
+		}
+		label := fmt.Sprintf("[%s] %s %s - %d BHP - $%.0f", c.Category, c.Make, c.Model, c.BHP, price)
+		if ds.tab == dealershipSell && i == ds.profile.CurrentCarIndex {
+			label += " (DRIVING)"
+		}
+		locked := false
+		if required := progression.CarLevel(c); ds.tab == dealershipBuy && !progression.Unlocked(required, ds.profile.Level) {
+			label = fmt.Sprintf("[%s] %s %s - LOCKED UNTIL LEVEL %d", c.Category, c.Make, c.Model, required)
+			locked = true
+		}
+
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{255, 255, 255, 255}
+		if i == selected {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		if ds.tab == dealershipBuy && price > ds.profile.Money {
+			textColor = color.RGBA{150, 100, 100, 255} // Too expensive
+		}
+		if locked {
+			textColor = color.RGBA{110, 110, 110, 255}
+		}
+		drawButton(screen, label, buttonX, startY+float64(i-first)*rowSpacing, buttonWidth, buttonHeight, bgColor, textColor)
+	}
+
+	if ds.message != "" {
+		messageColor := color.RGBA{100, 255, 100, 255}
+		if ds.failed {
+			messageColor = color.RGBA{255, 100, 100, 255}
+		}
+		drawText(screen, ds.message, centerX, float64(height)-85, 18, messageColor)
+	}
+
+	drawText(screen, "Left/Right: Buy/Sell | Up/Down: Choose | Enter: Confirm | Esc: Garage", centerX, float64(height)-50, 18, color.RGBA{150, 150, 150, 255})
+}
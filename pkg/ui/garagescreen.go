@@ -3,32 +3,62 @@ package ui
 import (
 	"fmt"
 	"image/color"
+	"strings"
 
-	"github.com/golangdaddy/roadster/pkg/models"
+	"github.com/golangdaddy/roadster/pkg/licence"
 	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/hajimehoshi/bitmapfont/v4"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
-	"github.com/hajimehoshi/bitmapfont/v4"
 )
 
-// GarageScreen represents the car selection screen
+// garageVisibleRows is how many cars fit on screen; the list scrolls to keep the selection in view
+const garageVisibleRows = 4
+
+// GarageScreen lists the player's own cars to drive
 type GarageScreen struct {
+	cars             []*car.Car
+	money            float64
+	licence          licence.Licence
 	selectedCarIndex int
-	onCarSelected    func(*car.Car) // Callback when car is selected
+	message          string          // Why the driver course could not be taken
+	onCarSelected    func(index int) // Callback with the index of the chosen car
+	onDealership     func()
+	onTuning         func(index int) // Opens the tuning shop for the car at index
+	onLevelSelect    func()
+	onCourse         func() error // Takes the driver course that lifts a suspension
 }
 
-// NewGarageScreen creates a new garage selection screen
-func NewGarageScreen(onCarSelected func(*car.Car)) *GarageScreen {
+// NewGarageScreen creates a garage of the player's owned cars, with currentIndex (the car last driven) selected
+func NewGarageScreen(cars []*car.Car, currentIndex int, money float64, lic licence.Licence, onCarSelected func(index int), onDealership func(), onTuning func(index int), onLevelSelect func(), onCourse func() error) *GarageScreen {
 	return &GarageScreen{
-		selectedCarIndex: 0,
+		cars:             cars,
+		money:            money,
+		licence:          lic,
+		selectedCarIndex: max(0, min(currentIndex, len(cars)-1)),
 		onCarSelected:    onCarSelected,
+		onDealership:     onDealership,
+		onTuning:         onTuning,
+		onLevelSelect:    onLevelSelect,
+		onCourse:         onCourse,
 	}
 }
 
 // Update handles input for the garage screen
 func (gs *GarageScreen) Update() error {
-	cars := models.CarInventory.GetAllCars()
+	if inpututil.IsKeyJustPressed(ebiten.KeyD) && gs.onDealership != nil {
+		gs.onDealership()
+		return nil
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) && gs.licence.Suspended && gs.onCourse != nil {
+		if err := gs.onCourse(); err != nil {
+			gs.message = err.Error()
+		}
+		return nil
+	}
+
+	cars := gs.cars
 	if len(cars) == 0 {
 		return nil
 	}
@@ -47,12 +77,21 @@ func (gs *GarageScreen) Update() error {
 		}
 	}
 
+	if inpututil.IsKeyJustPressed(ebiten.KeyL) && gs.onLevelSelect != nil {
+		gs.onLevelSelect()
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyT) && gs.onTuning != nil {
+		gs.onTuning(gs.selectedCarIndex)
+		return nil
+	}
+
 	// Handle selection
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
 		if gs.selectedCarIndex >= 0 && gs.selectedCarIndex < len(cars) {
-			selectedCar := cars[gs.selectedCarIndex]
 			if gs.onCarSelected != nil {
-				gs.onCarSelected(selectedCar)
+				gs.onCarSelected(gs.selectedCarIndex)
 			}
 		}
 	}
@@ -63,12 +102,12 @@ func (gs *GarageScreen) Update() error {
 // Draw renders the garage screen
 func (gs *GarageScreen) Draw(screen *ebiten.Image) {
 	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
-	
+
 	// Draw background
 	screen.Fill(color.RGBA{20, 20, 30, 255})
 
 	// Title
-	titleText := "SELECT CAR"
+	titleText := "YOUR GARAGE"
 	face := text.NewGoXFace(bitmapfont.Face)
 	textWidth := text.Advance(titleText, face)
 	titleScale := 4.0
@@ -76,33 +115,42 @@ func (gs *GarageScreen) Draw(screen *ebiten.Image) {
 	centerX := float64(width) / 2
 	titleX := centerX - scaledTextWidth/2
 	titleY := 50.0
-	
+
 	titleOp := &text.DrawOptions{}
 	titleOp.GeoM.Scale(titleScale, titleScale)
 	titleOp.GeoM.Translate(titleX, titleY)
 	titleOp.ColorScale.ScaleWithColor(color.RGBA{255, 200, 50, 255})
 	text.Draw(screen, titleText, face, titleOp)
 
+	drawText(screen, fmt.Sprintf("MONEY: $%.2f | LICENCE: %d / %d POINTS", gs.money, gs.licence.Points, licence.SuspensionPoints), centerX, 125, 18, color.RGBA{100, 255, 100, 255})
+	if gs.message != "" {
+		drawText(screen, gs.message, centerX, float64(height)-135, 18, color.RGBA{255, 100, 100, 255})
+	} else if gs.licence.Suspended {
+		drawText(screen, fmt.Sprintf("LICENCE SUSPENDED - NO TAXI OR HGV WORK UNTIL YOU TAKE THE $%.0f DRIVER COURSE", licence.CourseFee), centerX, float64(height)-135, 18, color.RGBA{255, 100, 100, 255})
+	}
+
 	// Draw car list
-	cars := models.CarInventory.GetAllCars()
+	cars := gs.cars
 	if len(cars) == 0 {
-		drawText(screen, "No cars available", centerX, float64(height)/2, 24, color.RGBA{255, 255, 255, 255})
+		drawText(screen, "No cars - visit the dealership", centerX, float64(height)/2, 24, color.RGBA{255, 255, 255, 255})
+		drawText(screen, "D: Dealership", centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
 		return
 	}
 
 	// Car list starting position
 	startY := 150.0
-	carSpacing := 80.0
+	carSpacing := 70.0
 	buttonWidth := 600.0
 	buttonHeight := 60.0
 	buttonX := centerX - buttonWidth/2
 
-	for i, c := range cars {
-		carY := startY + float64(i)*carSpacing
-		
+	first, last := visibleRows(gs.selectedCarIndex, len(cars), garageVisibleRows)
+	for i := first; i < last; i++ {
+		carY := startY + float64(i-first)*carSpacing
+
 		// Car info text
-		carInfo := formatCarInfo(c)
-		
+		carInfo := formatCarInfo(cars[i])
+
 		// Button colors
 		bgColor := color.RGBA{40, 40, 60, 255}
 		textColor := color.RGBA{255, 255, 255, 255}
@@ -110,18 +158,52 @@ func (gs *GarageScreen) Draw(screen *ebiten.Image) {
 			bgColor = color.RGBA{60, 100, 140, 255}
 			textColor = color.RGBA{200, 240, 255, 255}
 		}
-		
+
 		drawButton(screen, carInfo, buttonX, carY, buttonWidth, buttonHeight, bgColor, textColor)
 	}
 
+	// Selected car's performance and upgrades
+	selected := cars[gs.selectedCarIndex]
+	drawText(screen, formatCarStats(selected), centerX, float64(height)-110, 16, color.RGBA{200, 200, 200, 255})
+	drawText(screen, formatUpgrades(selected), centerX, float64(height)-85, 16, color.RGBA{255, 215, 0, 255})
+
 	// Instructions
-	drawText(screen, "Arrow Keys: Navigate | Enter: Select", centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
+	instructions := "Arrow Keys: Navigate | Enter: Drive | L: Level | T: Tuning | D: Dealership"
+	if gs.licence.Suspended {
+		instructions += " | C: Course"
+	}
+	drawText(screen, instructions, centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
+}
+
+// visibleRows returns the range [first, last) of a list of total rows to show so that selected stays on screen
+func visibleRows(selected, total, rows int) (first, last int) {
+	first = max(0, min(selected-rows/2, total-rows))
+	return first, min(total, first+rows)
 }
 
 // formatCarInfo formats car information for display
 func formatCarInfo(c *car.Car) string {
-	brakeEfficiency := c.Brakes.Condition * c.Brakes.Performance * c.Brakes.StoppingPower
+	brakeEfficiency := c.Brakes.Performance * c.EffectiveStats().StoppingPower // Stopping power includes brake condition
 	return fmt.Sprintf("%s %s (%d) - Weight: %.0f kg | Brake Eff: %.1f%% | Type: %s",
 		c.Make, c.Model, c.Year, c.Weight, brakeEfficiency*100, c.Brakes.Type)
 }
 
+// formatCarStats formats a car's performance with its upgrades fitted and its damage taken into account
+func formatCarStats(c *car.Car) string {
+	stats := c.EffectiveStats()
+	return fmt.Sprintf("%.0f BHP | Tank: %.0f L | Stopping Power: %.0f%% | Grip: %.0f%% | Damage: %.0f%%",
+		stats.BHP, stats.FuelCapacity, stats.StoppingPower*100, stats.Grip*100, c.DamageLevel()*100)
+}
+
+// formatUpgrades lists the upgrades fitted to a car
+func formatUpgrades(c *car.Car) string {
+	fitted := c.FittedParts()
+	if len(fitted) == 0 {
+		return "Upgrades: none"
+	}
+	names := make([]string, len(fitted))
+	for i, part := range fitted {
+		names[i] = part.Name
+	}
+	return "Upgrades: " + strings.Join(names, ", ")
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/leaderboard"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// leaderboardRowHeight is the height of one entry in a leaderboard table
+const leaderboardRowHeight = 26.0
+
+// drawLeaderboard draws up to rows entries of a leaderboard table with its top left corner at x, y.
+// The entry at place highlight (1 for first) is picked out; pass 0 for none.
+func drawLeaderboard(screen *ebiten.Image, entries []leaderboard.Entry, x, y float64, rows, highlight int) {
+	face := text.NewGoXFace(bitmapfont.Face)
+	if len(entries) == 0 {
+		drawTextAt(screen, "NO SCORES YET", x, y+leaderboardRowHeight/2, 16, color.RGBA{150, 150, 150, 255}, face)
+		return
+	}
+
+	for i, entry := range entries[:min(rows, len(entries))] {
+		rowY := y + float64(i)*leaderboardRowHeight
+		clr := color.RGBA{220, 220, 220, 255}
+		if i+1 == highlight {
+			clr = color.RGBA{255, 215, 0, 255}
+		}
+
+		// Headshots are 128x128; shrink them to fit the row
+		if headshot, err := assets.Default.Image(entry.HeadshotPath); err == nil && entry.HeadshotPath != "" {
+			size := float64(headshot.Bounds().Dx())
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale((leaderboardRowHeight-4)/size, (leaderboardRowHeight-4)/size)
+			op.GeoM.Translate(x+28, rowY+2)
+			screen.DrawImage(headshot, op)
+		}
+
+		drawTextAt(screen, fmt.Sprintf("%2d.", i+1), x, rowY+leaderboardRowHeight/2, 16, clr, face)
+		line := fmt.Sprintf("%-12.12s %7d  %s  %5.1f MI  %s", entry.PlayerName, entry.Score, formatLapTime(entry.Time), entry.Miles, entry.Car)
+		drawTextAt(screen, line, x+56, rowY+leaderboardRowHeight/2, 16, clr, face)
+	}
+}
@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/leaderboard"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// LevelChoice is a level listed on the level select screen
+type LevelChoice struct {
+	Name          string
+	RequiredLevel int                            // Player level needed to drive it
+	Unlocked      bool                           // Whether the player has reached RequiredLevel
+	Leaderboards  map[string][]leaderboard.Entry // Keyed by ModeChoice.ID
+}
+
+// ModeChoice is a game mode the level can be driven in
+type ModeChoice struct {
+	ID     string
+	Name   string
+	Locked string // Why the mode cannot be driven; "" if it can
+}
+
+// LevelSelectScreen lets the player pick which of the unlocked levels to drive
+type LevelSelectScreen struct {
+	levels      []LevelChoice
+	modes       []ModeChoice
+	mode        int // Index into modes
+	playerLevel int
+	selected    int
+	message     string // Shown when a locked level or mode is chosen
+
+	onSelect func(index int, mode string)
+	onBack   func()
+}
+
+// NewLevelSelectScreen creates a level select screen with current (the level last played) selected and the first mode chosen
+func NewLevelSelectScreen(levels []LevelChoice, modes []ModeChoice, playerLevel, current int, onSelect func(index int, mode string), onBack func()) *LevelSelectScreen {
+	return &LevelSelectScreen{
+		levels:      levels,
+		modes:       modes,
+		playerLevel: playerLevel,
+		selected:    max(0, min(current, len(levels)-1)),
+		onSelect:    onSelect,
+		onBack:      onBack,
+	}
+}
+
+// Update handles input for the level select screen
+func (ls *LevelSelectScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if ls.onBack != nil {
+			ls.onBack()
+		}
+		return nil
+	}
+	if len(ls.levels) == 0 || len(ls.modes) == 0 {
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		ls.selected = (ls.selected + len(ls.levels) - 1) % len(ls.levels)
+		ls.message = ""
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		ls.selected = (ls.selected + 1) % len(ls.levels)
+		ls.message = ""
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		ls.mode = (ls.mode + len(ls.modes) - 1) % len(ls.modes)
+		ls.message = ""
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		ls.mode = (ls.mode + 1) % len(ls.modes)
+		ls.message = ""
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		level := ls.levels[ls.selected]
+		if !level.Unlocked {
+			ls.message = fmt.Sprintf("UNLOCKS AT PLAYER LEVEL %d", level.RequiredLevel)
+			return nil
+		}
+		if mode := ls.modes[ls.mode]; mode.Locked != "" {
+			ls.message = mode.Locked
+			return nil
+		}
+		if ls.onSelect != nil {
+			ls.onSelect(ls.selected, ls.modes[ls.mode].ID)
+		}
+	}
+
+	return nil
+}
+
+// Draw renders the level select screen
+func (ls *LevelSelectScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+	screen.Fill(color.RGBA{20, 20, 30, 255})
+
+	centerX := float64(width) / 2
+	face := text.NewGoXFace(bitmapfont.Face)
+	drawText(screen, "SELECT LEVEL", centerX, 50, 40, color.RGBA{255, 200, 50, 255})
+	modeName := ""
+	if len(ls.modes) > 0 {
+		modeName = ls.modes[ls.mode].Name
+		if ls.modes[ls.mode].Locked != "" {
+			modeName += " (LOCKED)"
+		}
+	}
+	drawText(screen, fmt.Sprintf("PLAYER LEVEL: %d | MODE: < %s >", ls.playerLevel, modeName), centerX, 95, 18, color.RGBA{255, 215, 0, 255})
+
+	startY := 130.0
+	rowSpacing := 60.0
+	buttonWidth := 420.0
+	buttonHeight := 50.0
+	buttonX := 40.0
+
+	first, last := visibleRows(ls.selected, len(ls.levels), garageVisibleRows+2)
+	for i := first; i < last; i++ {
+		level := ls.levels[i]
+		label := "LEVEL " + level.Name
+		if !level.Unlocked {
+			label += fmt.Sprintf(" - NEEDS PLAYER LEVEL %d", level.RequiredLevel)
+		}
+
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{255, 255, 255, 255}
+		if i == ls.selected {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		if !level.Unlocked {
+			textColor = color.RGBA{110, 110, 110, 255}
+		}
+		drawButton(screen, label, buttonX, startY+float64(i-first)*rowSpacing, buttonWidth, buttonHeight, bgColor, textColor)
+	}
+
+	// Leaderboard of the selected level and mode
+	if len(ls.levels) > 0 && len(ls.modes) > 0 {
+		boardX := buttonX + buttonWidth + 30
+		level, mode := ls.levels[ls.selected], ls.modes[ls.mode]
+		drawTextAt(screen, "LEADERBOARD - LEVEL "+level.Name+" - "+mode.Name, boardX, startY, 18, color.RGBA{255, 215, 0, 255}, face)
+		drawLeaderboard(screen, level.Leaderboards[mode.ID], boardX, startY+20, leaderboard.Size, 0)
+	}
+
+	if ls.message != "" {
+		drawText(screen, ls.message, centerX, float64(height)-85, 18, color.RGBA{255, 100, 100, 255})
+	}
+
+	drawText(screen, "Up/Down: Level | Left/Right: Mode | Enter: Drive | Esc: Garage", centerX, float64(height)-50, 18, color.RGBA{150, 150, 150, 255})
+}
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/golangdaddy/roadster/pkg/assets"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/hajimehoshi/bitmapfont/v4"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+)
+
+// loadGameVisibleRows is how many saved profiles fit on screen at once
+const loadGameVisibleRows = 4
+
+// LoadGameScreen lists saved profiles so one can be resumed
+type LoadGameScreen struct {
+	profiles      []*profile.PlayerProfile
+	headshots     []*ebiten.Image
+	selectedIndex int
+	onSelect      func(*profile.PlayerProfile) // Callback when a profile is resumed
+	onBack        func()                       // Callback when the player backs out
+}
+
+// NewLoadGameScreen creates a load game screen for the given saved profiles
+func NewLoadGameScreen(profiles []*profile.PlayerProfile, onSelect func(*profile.PlayerProfile), onBack func()) *LoadGameScreen {
+	screen := &LoadGameScreen{
+		profiles:  profiles,
+		headshots: make([]*ebiten.Image, len(profiles)),
+		onSelect:  onSelect,
+		onBack:    onBack,
+	}
+
+	for i, p := range profiles {
+		if img, err := assets.Default.Image(p.HeadshotPath); err == nil {
+			screen.headshots[i] = img
+		}
+	}
+
+	return screen
+}
+
+// Update handles input for the load game screen
+func (lg *LoadGameScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if lg.onBack != nil {
+			lg.onBack()
+		}
+		return nil
+	}
+
+	if len(lg.profiles) == 0 {
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		lg.selectedIndex--
+		if lg.selectedIndex < 0 {
+			lg.selectedIndex = len(lg.profiles) - 1
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		lg.selectedIndex++
+		if lg.selectedIndex >= len(lg.profiles) {
+			lg.selectedIndex = 0
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		if lg.onSelect != nil {
+			lg.onSelect(lg.profiles[lg.selectedIndex])
+		}
+	}
+
+	return nil
+}
+
+// Draw renders the load game screen
+func (lg *LoadGameScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+	screen.Fill(color.RGBA{20, 20, 30, 255})
+
+	face := text.NewGoXFace(bitmapfont.Face)
+	centerX := float64(width) / 2
+
+	// Title
+	titleText := "LOAD GAME"
+	titleScale := 4.0
+	titleOp := &text.DrawOptions{}
+	titleOp.GeoM.Scale(titleScale, titleScale)
+	titleOp.GeoM.Translate(centerX-text.Advance(titleText, face)*titleScale/2, 50)
+	titleOp.ColorScale.ScaleWithColor(color.RGBA{255, 200, 50, 255})
+	text.Draw(screen, titleText, face, titleOp)
+
+	if len(lg.profiles) == 0 {
+		drawText(screen, "No saved games found", centerX, float64(height)/2, 24, color.RGBA{255, 255, 255, 255})
+		drawText(screen, "Escape: Back", centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
+		return
+	}
+
+	// Scroll so the selected profile is always visible
+	firstRow := 0
+	if lg.selectedIndex >= loadGameVisibleRows {
+		firstRow = lg.selectedIndex - loadGameVisibleRows + 1
+	}
+
+	rowWidth := 600.0
+	rowHeight := 90.0
+	rowSpacing := 100.0
+	rowX := centerX - rowWidth/2
+	startY := 130.0
+
+	for row := 0; row < loadGameVisibleRows && firstRow+row < len(lg.profiles); row++ {
+		i := firstRow + row
+		p := lg.profiles[i]
+		rowY := startY + float64(row)*rowSpacing
+
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{255, 255, 255, 255}
+		if i == lg.selectedIndex {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		drawButton(screen, "", rowX, rowY, rowWidth, rowHeight, bgColor, textColor)
+
+		// Headshot (left side of the row)
+		if lg.headshots[i] != nil {
+			op := &ebiten.DrawImageOptions{}
+			scale := 70.0 / float64(lg.headshots[i].Bounds().Dx())
+			op.GeoM.Scale(scale, scale)
+			op.GeoM.Translate(rowX+10, rowY+10)
+			screen.DrawImage(lg.headshots[i], op)
+		}
+
+		// Details
+		lastPlayed := "never"
+		if !p.LastPlayed.IsZero() {
+			lastPlayed = p.LastPlayed.Format("02 Jan 2006 15:04")
+		}
+		details := fmt.Sprintf("%s\nLEVEL %d    MONEY $%.0f\nLAST PLAYED %s", p.Name, p.Level, p.Money, lastPlayed)
+
+		textOp := &text.DrawOptions{}
+		textOp.GeoM.Translate(rowX+100, rowY+15)
+		textOp.LineSpacing = 22
+		textOp.ColorScale.ScaleWithColor(textColor)
+		text.Draw(screen, details, face, textOp)
+	}
+
+	drawText(screen, "Arrow Keys: Navigate | Enter: Resume | Escape: Back", centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
+}
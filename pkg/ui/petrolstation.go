@@ -4,124 +4,240 @@ import (
 	"fmt"
 	"image/color"
 
+	"github.com/golangdaddy/roadster/pkg/economy"
 	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/hajimehoshi/bitmapfont/v4"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
-	"github.com/hajimehoshi/bitmapfont/v4"
 )
 
-// PetrolStationScreen represents the petrol station refueling screen
+// fuelStep is how many litres the amount changes by per key press
+const fuelStep = 5.0
+
+// Rows of the petrol station panel
+const (
+	petrolRowGrade = iota
+	petrolRowAmount
+	petrolRowPay
+	petrolRowCount
+)
+
+// PetrolStationScreen is the pump panel shown over the road when the player stops at a petrol station.
+// FuelLevel and TankCapacity are litres, as everywhere else.
 type PetrolStationScreen struct {
-	carModel      *car.Car
-	onExit        func()
-	litersToAdd   float64
-	maxLiters     float64
+	carModel     *car.Car
+	stationPrice float64 // Price per litre of unleaded here
+	balance      float64 // Money the player has
+	onPurchase   func(litres, pricePerLitre float64) bool
+	onExit       func()
+
+	grade      int     // Index into economy.FuelGrades
+	litres     float64 // Amount selected; equal to FuelNeeded for a full tank
+	row        int
+	confirming bool
+	message    string // Shown when a purchase is refused
 }
 
-// NewPetrolStationScreen creates a new petrol station screen
-func NewPetrolStationScreen(carModel *car.Car, onExit func()) *PetrolStationScreen {
-	if carModel == nil || carModel.FuelCapacity <= 0 {
+// NewPetrolStationScreen creates the pump panel. onPurchase is asked to take payment and fill the tank,
+// and reports whether the sale went through; onExit closes the panel.
+func NewPetrolStationScreen(carModel *car.Car, stationPrice, balance float64, onPurchase func(litres, pricePerLitre float64) bool, onExit func()) *PetrolStationScreen {
+	if carModel == nil || carModel.TankCapacity() <= 0 {
 		return nil
 	}
-	
-	// Calculate how much fuel can be added
-	currentLiters := carModel.FuelLevel * carModel.FuelCapacity
-	maxLiters := carModel.FuelCapacity
-	litersToAdd := maxLiters - currentLiters
-	
+
 	return &PetrolStationScreen{
-		carModel:    carModel,
-		onExit:      onExit,
-		litersToAdd: litersToAdd,
-		maxLiters:   maxLiters,
+		carModel:     carModel,
+		stationPrice: stationPrice,
+		balance:      balance,
+		onPurchase:   onPurchase,
+		onExit:       onExit,
+		litres:       carModel.FuelNeeded(), // Fill up unless told otherwise
+		row:          petrolRowPay,
 	}
 }
 
+// pricePerLitre is the price of the selected grade
+func (ps *PetrolStationScreen) pricePerLitre() float64 {
+	return economy.GradePrice(ps.stationPrice, economy.FuelGrades[ps.grade])
+}
+
+// total is what the selected amount costs
+func (ps *PetrolStationScreen) total() float64 {
+	return ps.litres * ps.pricePerLitre()
+}
+
+// tankFull reports whether there is no room worth paying for
+func (ps *PetrolStationScreen) tankFull() bool {
+	return ps.carModel.FuelNeeded() < 0.1
+}
+
 // Update handles input for the petrol station screen
 func (ps *PetrolStationScreen) Update() error {
 	if ps.carModel == nil {
 		return nil
 	}
 
-	// Check for exit (Escape key)
-	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-		if ps.onExit != nil {
-			ps.onExit()
+	if ps.confirming {
+		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+			ps.confirming = false
+			if ps.onPurchase != nil && ps.onPurchase(ps.litres, ps.pricePerLitre()) {
+				ps.exit()
+				return nil
+			}
+			ps.message = "NOT ENOUGH MONEY"
+		} else if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+			ps.confirming = false
 		}
 		return nil
 	}
 
-	// Refuel on Enter/Space
+	// Leave without buying
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || ps.tankFull() && inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		ps.exit()
+		return nil
+	}
+	if ps.tankFull() {
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		ps.row = (ps.row + petrolRowCount - 1) % petrolRowCount
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		ps.row = (ps.row + 1) % petrolRowCount
+	}
+
+	change := 0
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		change = -1
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		change = 1
+	}
+	if change != 0 {
+		ps.message = ""
+		switch ps.row {
+		case petrolRowGrade:
+			ps.grade = (ps.grade + len(economy.FuelGrades) + change) % len(economy.FuelGrades)
+		case petrolRowAmount:
+			ps.stepAmount(change)
+		}
+	}
+
 	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
-		// Fill tank to full
-		ps.carModel.FuelLevel = 1.0
-		// Exit after refueling
-		if ps.onExit != nil {
-			ps.onExit()
+		if ps.row == petrolRowPay {
+			ps.confirming = true
+		} else {
+			ps.row++
 		}
-		return nil
 	}
 
 	return nil
 }
 
-// Draw renders the petrol station screen
+// stepAmount moves the selected amount by one fuelStep, between one step and a full tank
+func (ps *PetrolStationScreen) stepAmount(direction int) {
+	needed := ps.carModel.FuelNeeded()
+	if direction < 0 && ps.litres >= needed {
+		// Coming down from a full tank snaps to the step below it
+		ps.litres = float64(int((needed-0.01)/fuelStep)) * fuelStep
+	} else {
+		ps.litres += float64(direction) * fuelStep
+	}
+	ps.litres = max(min(fuelStep, needed), min(needed, ps.litres))
+}
+
+// exit closes the panel
+func (ps *PetrolStationScreen) exit() {
+	if ps.onExit != nil {
+		ps.onExit()
+	}
+}
+
+// Draw renders the pump panel over whatever is already on screen
 func (ps *PetrolStationScreen) Draw(screen *ebiten.Image) {
-	width := screen.Bounds().Dx()
-	
-	// Background (dark gray)
-	screen.Fill(color.RGBA{40, 40, 50, 255})
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+
+	// Dim the road behind the panel
+	shade := ebiten.NewImage(width, height)
+	shade.Fill(color.RGBA{0, 0, 0, 150})
+	screen.DrawImage(shade, nil)
+
+	panelWidth, panelHeight := 520.0, 340.0
+	panelX := float64(width)/2 - panelWidth/2
+	panelY := float64(height)/2 - panelHeight/2
+	panel := ebiten.NewImage(int(panelWidth), int(panelHeight))
+	panel.Fill(color.RGBA{40, 40, 50, 240})
+	panelOp := &ebiten.DrawImageOptions{}
+	panelOp.GeoM.Translate(panelX, panelY)
+	screen.DrawImage(panel, panelOp)
 
 	face := text.NewGoXFace(bitmapfont.Face)
-	
+
 	// Title
 	titleColor := color.RGBA{255, 200, 0, 255} // Yellow/gold
 	titleText := "PETROL STATION"
 	titleSize := 32.0
 	titleWidth := text.Advance(titleText, face) * (titleSize / 16.0)
 	titleX := float64(width)/2 - titleWidth/2
-	titleY := 80.0
-	drawTextAt(screen, titleText, titleX, titleY, titleSize, titleColor, face)
+	drawTextAt(screen, titleText, titleX, panelY+35, titleSize, titleColor, face)
+
+	if ps.carModel == nil {
+		return
+	}
 
-	// Car info
 	textColor := color.RGBA{200, 200, 200, 255}
+	selectedColor := color.RGBA{200, 240, 255, 255}
+	instructionColor := color.RGBA{150, 150, 200, 255}
 	lineHeight := 30.0
-	currentY := 150.0
-	startX := float64(width) / 2 - 200.0
+	startX := panelX + 30
+	currentY := panelY + 80
 
-	if ps.carModel != nil {
-		carInfoText := fmt.Sprintf("Car: %s %s", ps.carModel.Make, ps.carModel.Model)
-		drawTextAt(screen, carInfoText, startX, currentY, 18, textColor, face)
-		currentY += lineHeight
+	fuelText := fmt.Sprintf("%s %s: %.1f / %.1f L (%.0f%%)", ps.carModel.Make, ps.carModel.Model,
+		ps.carModel.FuelLevel, ps.carModel.TankCapacity(), ps.carModel.FuelFraction()*100)
+	drawTextAt(screen, fuelText, startX, currentY, 16, textColor, face)
+	currentY += lineHeight
+	drawTextAt(screen, fmt.Sprintf("Wallet: $%.2f", ps.balance), startX, currentY, 16, textColor, face)
+	currentY += lineHeight * 1.5
+
+	if ps.tankFull() {
+		drawTextAt(screen, "Tank is full!", startX, currentY, 18, color.RGBA{100, 255, 100, 255}, face)
+		drawTextAt(screen, "Press ENTER or ESCAPE to drive on", startX, panelY+panelHeight-30, 14, instructionColor, face)
+		return
+	}
 
-		// Current fuel
-		currentLiters := ps.carModel.FuelLevel * ps.carModel.FuelCapacity
-		fuelText := fmt.Sprintf("Current Fuel: %.1f / %.1f L (%.1f%%)",
-			currentLiters, ps.carModel.FuelCapacity, ps.carModel.FuelLevel*100)
-		drawTextAt(screen, fuelText, startX, currentY, 18, textColor, face)
-		currentY += lineHeight * 1.5
-
-		// Fuel to add
-		if ps.litersToAdd > 0.01 {
-			addText := fmt.Sprintf("Fuel to add: %.1f L", ps.litersToAdd)
-			drawTextAt(screen, addText, startX, currentY, 18, textColor, face)
+	amount := fmt.Sprintf("%.0f L", ps.litres)
+	if ps.litres >= ps.carModel.FuelNeeded() {
+		amount = fmt.Sprintf("FULL TANK (%.1f L)", ps.litres)
+	}
+	rows := []string{
+		fmt.Sprintf("GRADE:  < %s $%.2f/L >", economy.FuelGrades[ps.grade].Name, ps.pricePerLitre()),
+		fmt.Sprintf("AMOUNT: < %s >", amount),
+		fmt.Sprintf("PAY $%.2f", ps.total()),
+	}
+	for i, row := range rows {
+		clr := textColor
+		if i == ps.row {
+			clr = selectedColor
+			row = "> " + row
 		} else {
-			fullText := "Tank is full!"
-			fullColor := color.RGBA{100, 255, 100, 255} // Green
-			drawTextAt(screen, fullText, startX, currentY, 18, fullColor, face)
+			row = "  " + row
+		}
+		if i == petrolRowPay && ps.total() > ps.balance {
+			clr = color.RGBA{255, 100, 100, 255}
 		}
-		currentY += lineHeight * 2
+		drawTextAt(screen, row, startX, currentY, 18, clr, face)
+		currentY += lineHeight
 	}
 
-	// Instructions
-	instructionColor := color.RGBA{150, 150, 200, 255}
-	instructionText := "Press ENTER or SPACE to refuel"
-	drawTextAt(screen, instructionText, startX, currentY, 16, instructionColor, face)
-	currentY += lineHeight
-
-	exitText := "Press ESCAPE to exit without refueling"
-	drawTextAt(screen, exitText, startX, currentY, 14, instructionColor, face)
+	instructions := "Arrows: Choose | Enter: Next/Pay | Esc: Leave"
+	if ps.confirming {
+		instructions = fmt.Sprintf("Pay $%.2f for %.1f L? ENTER: Yes | ESC: No", ps.total(), ps.litres)
+	} else if ps.message != "" {
+		drawTextAt(screen, ps.message, startX, currentY+10, 16, color.RGBA{255, 100, 100, 255}, face)
+	}
+	drawTextAt(screen, instructions, startX, panelY+panelHeight-30, 14, instructionColor, face)
 }
 
 // drawTextAt draws text at a specific position (helper function)
@@ -139,4 +255,3 @@ func drawTextAt(screen *ebiten.Image, str string, x, y float64, size float64, cl
 
 	text.Draw(screen, str, face, op)
 }
-
@@ -0,0 +1,372 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"github.com/golangdaddy/roadster/pkg/leaderboard"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// RunOutcome is how a run on a level ended
+type RunOutcome int
+
+const (
+	RunCompleted RunOutcome = iota // Reached the end of the level, or took an exit
+	RunGameOver                    // Too many crashes
+	RunQuit                        // Left from the pause menu
+	RunShiftOver                   // Too tired to drive another taxi fare
+	RunBusted                      // Arrested at the end of a police chase
+)
+
+// RunResults summarises one run on a level
+type RunResults struct {
+	Outcome   RunOutcome
+	LevelName string
+	Mode      string
+	ExitTaken string // Destination of the exit the player left by ("" if they drove to the end)
+
+	Miles           float64
+	CarsPassed      int
+	Crashes         int
+	FuelUsed        float64 // Litres
+	AverageSpeedMPH float64
+	Duration        time.Duration
+
+	// Level progress: the player's XP and how far it is through their level
+	Level         int
+	LevelsGained  int
+	XPGained      int
+	XP            int
+	NextLevelXP   int     // Total XP needed for the next level
+	LevelProgress float64 // 0 to 1
+	NextLevelNote string  // Why there is no Next Level option, if the next level is locked
+
+	// Money made and spent this run, and what the player has left
+	MoneyEarned float64
+	MoneySpent  float64
+	Balance     float64
+
+	// Score, and the best on this level including this run
+	Score      int
+	BestCombo  int
+	NearMisses int
+	BestScore  int
+	NewBest    bool
+
+	// Time trial: the run's time (zero if it did not reach the end) and the best on the level including this run
+	TimeTrial   bool
+	TrialTime   time.Duration
+	BestTime    time.Duration
+	NewBestTime bool
+
+	// Taxi: fares taken and trips driven this shift, the shift's average stars and the career average after it
+	Taxi        bool
+	Fares       float64
+	Trips       int
+	ShiftRating float64
+	TaxiRating  float64
+	NextCab     string // What it takes to be given a better cab; "" if the player has the best
+
+	// HGV: full breaks taken, and fines for going over the tachograph's driving limit
+	HGV           bool
+	Breaks        int
+	Infringements int
+	Fines         float64
+
+	// Speeding tickets this run, and the licence they left the player with
+	Offences         int
+	PenaltyPoints    int
+	OffenceFines     float64
+	LicencePoints    int
+	LicenceSuspended bool
+
+	// Police chases: cars stolen this run and chases escaped
+	CarsStolen int
+	Escapes    int
+
+	// The level and mode's leaderboard after this run, and the run's place on it (0 if it did not place)
+	Leaderboard []leaderboard.Entry
+	Rank        int
+}
+
+// Title returns the heading shown for the outcome
+func (r RunResults) Title() string {
+	switch r.Outcome {
+	case RunCompleted:
+		return "LEVEL COMPLETE"
+	case RunGameOver:
+		return "GAME OVER"
+	case RunShiftOver:
+		return "SHIFT OVER"
+	case RunBusted:
+		return "BUSTED"
+	default:
+		return "RUN ENDED"
+	}
+}
+
+// Result screen options
+const (
+	resultsRetry = iota
+	resultsNextLevel
+	resultsGarage
+)
+
+// ResultsScreen shows how a run went and what to do next
+type ResultsScreen struct {
+	results        RunResults
+	options        []int
+	selectedOption int
+	onRetry        func()
+	onNextLevel    func() // nil when there is no next level to go to
+	onGarage       func()
+
+	showLeaderboard bool // Show the level's leaderboard in place of the run's stats
+}
+
+// NewResultsScreen creates a results screen. Pass a nil onNextLevel to hide the Next Level option.
+func NewResultsScreen(results RunResults, onRetry, onNextLevel, onGarage func()) *ResultsScreen {
+	rs := &ResultsScreen{
+		results:     results,
+		onRetry:     onRetry,
+		onNextLevel: onNextLevel,
+		onGarage:    onGarage,
+	}
+
+	rs.options = []int{resultsRetry}
+	if onNextLevel != nil {
+		rs.options = append(rs.options, resultsNextLevel)
+		rs.selectedOption = 1 // Carrying on is the usual choice after a completed level
+	}
+	rs.options = append(rs.options, resultsGarage)
+
+	return rs
+}
+
+// Update handles input for the results screen
+func (rs *ResultsScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyL) {
+		rs.showLeaderboard = !rs.showLeaderboard
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowLeft) {
+		rs.selectedOption--
+		if rs.selectedOption < 0 {
+			rs.selectedOption = len(rs.options) - 1
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowRight) {
+		rs.selectedOption++
+		if rs.selectedOption >= len(rs.options) {
+			rs.selectedOption = 0
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		var callback func()
+		switch rs.options[rs.selectedOption] {
+		case resultsRetry:
+			callback = rs.onRetry
+		case resultsNextLevel:
+			callback = rs.onNextLevel
+		case resultsGarage:
+			callback = rs.onGarage
+		}
+		if callback != nil {
+			callback()
+		}
+	}
+
+	return nil
+}
+
+// Draw renders the results screen
+func (rs *ResultsScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+	screen.Fill(color.RGBA{20, 20, 30, 255})
+
+	centerX := float64(width) / 2
+	r := rs.results
+
+	titleColor := color.RGBA{100, 255, 100, 255}
+	if r.Outcome == RunGameOver || r.Outcome == RunBusted {
+		titleColor = color.RGBA{255, 80, 80, 255}
+	} else if r.Outcome == RunQuit {
+		titleColor = color.RGBA{255, 200, 50, 255}
+	}
+	drawText(screen, r.Title(), centerX, 60, 48, titleColor)
+
+	subtitle := "LEVEL " + r.LevelName
+	if r.ExitTaken != "" {
+		subtitle += " - LEFT BY THE EXIT TO " + r.ExitTaken
+	}
+	drawText(screen, subtitle, centerX, 100, 18, color.RGBA{150, 150, 150, 255})
+
+	scoreText := fmt.Sprintf("SCORE %d | BEST %d | BEST COMBO %d | NEAR MISSES %d", r.Score, r.BestScore, r.BestCombo, r.NearMisses)
+	scoreColor := color.RGBA{255, 255, 255, 255}
+	if r.NewBest {
+		scoreText = "NEW BEST! " + scoreText
+		scoreColor = color.RGBA{255, 215, 0, 255}
+	}
+	if r.TimeTrial {
+		scoreText, scoreColor = trialText(r)
+	}
+	if r.Taxi {
+		scoreText = fmt.Sprintf("FARES $%.2f | TRIPS %d | SHIFT RATING %.1f | CAREER RATING %.1f", r.Fares, r.Trips, r.ShiftRating, r.TaxiRating)
+		scoreColor = color.RGBA{255, 215, 0, 255}
+	}
+	if r.Rank > 0 {
+		scoreText += fmt.Sprintf(" | #%d ON THE LEADERBOARD", r.Rank)
+	}
+	drawText(screen, scoreText, centerX, 130, 18, scoreColor)
+
+	// Stats, two columns
+	stats := []struct {
+		label string
+		value string
+	}{
+		{"MILES", fmt.Sprintf("%.1f", r.Miles)},
+		{"CARS PASSED", fmt.Sprintf("%d", r.CarsPassed)},
+		{"CRASHES", fmt.Sprintf("%d", r.Crashes)},
+		{"FUEL USED", fmt.Sprintf("%.1f L", r.FuelUsed)},
+		{"AVERAGE SPEED", fmt.Sprintf("%.0f MPH", r.AverageSpeedMPH)},
+		{"TIME", formatDuration(r.Duration)},
+		{"EARNED", fmt.Sprintf("$%.2f", r.MoneyEarned)},
+		{"SPENT", fmt.Sprintf("$%.2f (LEFT: $%.2f)", r.MoneySpent, r.Balance)},
+	}
+	statY := 165.0
+	if rs.showLeaderboard {
+		stats = nil
+		drawLeaderboard(screen, r.Leaderboard, centerX-300, statY-10, 7, r.Rank)
+	}
+	for i, stat := range stats {
+		x := centerX - 160
+		if i%2 == 1 {
+			x = centerX + 160
+		}
+		y := statY + float64(i/2)*50
+		drawText(screen, stat.label, x, y, 16, color.RGBA{150, 150, 150, 255})
+		drawText(screen, stat.value, x, y+22, 24, color.RGBA{255, 255, 255, 255})
+	}
+
+	// Level progress bar
+	barWidth, barHeight := 500.0, 20.0
+	barX := centerX - barWidth/2
+	barY := 370.0
+
+	levelLabel := fmt.Sprintf("LEVEL %d  +%d XP", r.Level, r.XPGained)
+	if r.LevelsGained > 0 {
+		levelLabel += fmt.Sprintf("  (+%d LEVELS THIS RUN)", r.LevelsGained)
+	}
+	drawText(screen, levelLabel, centerX, barY-20, 18, color.RGBA{255, 215, 0, 255})
+
+	progress := max(0, min(1, r.LevelProgress))
+
+	bg := ebiten.NewImage(int(barWidth), int(barHeight))
+	bg.Fill(color.RGBA{50, 50, 60, 255})
+	bgOp := &ebiten.DrawImageOptions{}
+	bgOp.GeoM.Translate(barX, barY)
+	screen.DrawImage(bg, bgOp)
+
+	if fillWidth := int(barWidth * progress); fillWidth > 0 {
+		fill := ebiten.NewImage(fillWidth, int(barHeight))
+		fill.Fill(color.RGBA{255, 215, 0, 255})
+		fillOp := &ebiten.DrawImageOptions{}
+		fillOp.GeoM.Translate(barX, barY)
+		screen.DrawImage(fill, fillOp)
+	}
+
+	progressText := fmt.Sprintf("%d / %d XP TO LEVEL %d", r.XP, r.NextLevelXP, r.Level+1)
+	if progress >= 1 {
+		progressText = fmt.Sprintf("%d XP", r.XP)
+	}
+	drawText(screen, progressText, centerX, barY+barHeight+20, 16, color.RGBA{200, 200, 200, 255})
+	if r.NextLevelNote != "" {
+		drawText(screen, r.NextLevelNote, centerX, barY+barHeight+45, 16, color.RGBA{255, 100, 100, 255})
+	} else if r.NextCab != "" {
+		drawText(screen, r.NextCab, centerX, barY+barHeight+45, 16, color.RGBA{200, 200, 200, 255})
+	} else if r.HGV {
+		drawText(screen, tachoText(r), centerX, barY+barHeight+45, 16, color.RGBA{200, 200, 200, 255})
+	}
+
+	// Buttons
+	buttonWidth, buttonHeight := 200.0, 50.0
+	spacing := 30.0
+	totalWidth := float64(len(rs.options))*buttonWidth + float64(len(rs.options)-1)*spacing
+	buttonY := float64(height) - 150
+
+	for i, option := range rs.options {
+		label := "RETRY"
+		switch option {
+		case resultsNextLevel:
+			label = "NEXT LEVEL"
+		case resultsGarage:
+			label = "GARAGE"
+		}
+
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{255, 255, 255, 255}
+		if i == rs.selectedOption {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		buttonX := centerX - totalWidth/2 + float64(i)*(buttonWidth+spacing)
+		drawButton(screen, label, buttonX, buttonY, buttonWidth, buttonHeight, bgColor, textColor)
+	}
+
+	if r.Offences > 0 || r.LicenceSuspended || r.CarsStolen > 0 {
+		drawText(screen, licenceText(r), centerX, float64(height)-85, 16, color.RGBA{255, 100, 100, 255})
+	}
+
+	drawText(screen, "Arrow Keys: Navigate | Enter: Select | L: Leaderboard", centerX, float64(height)-50, 20, color.RGBA{150, 150, 150, 255})
+}
+
+// trialText is the line summing up a time trial, in place of the score
+func trialText(r RunResults) (string, color.RGBA) {
+	line := "DID NOT FINISH"
+	if r.TrialTime > 0 {
+		line = "TIME " + formatLapTime(r.TrialTime)
+	}
+	if r.BestTime > 0 {
+		line += " | BEST " + formatLapTime(r.BestTime)
+	}
+	if r.NewBestTime {
+		return "NEW BEST TIME! " + line, color.RGBA{255, 215, 0, 255}
+	}
+	return line, color.RGBA{255, 255, 255, 255}
+}
+
+// tachoText is the line summing up an HGV run's tachograph record
+func tachoText(r RunResults) string {
+	if r.Infringements == 0 {
+		return fmt.Sprintf("TACHOGRAPH CLEAN | %d BREAKS TAKEN", r.Breaks)
+	}
+	return fmt.Sprintf("TACHOGRAPH: %d INFRINGEMENTS | $%.0f IN FINES | %d BREAKS TAKEN", r.Infringements, r.Fines, r.Breaks)
+}
+
+// licenceText is the line summing up a run's cars stolen, offences and the state of the licence
+func licenceText(r RunResults) string {
+	line := fmt.Sprintf("%d OFFENCES | +%d POINTS | $%.0f IN FINES | LICENCE %d POINTS", r.Offences, r.PenaltyPoints, r.OffenceFines, r.LicencePoints)
+	if r.CarsStolen > 0 {
+		line = fmt.Sprintf("%d CARS STOLEN | %d ESCAPES | ", r.CarsStolen, r.Escapes) + line
+	}
+	if r.LicenceSuspended {
+		line += " | SUSPENDED"
+	}
+	return line
+}
+
+// formatDuration formats a run time as m:ss
+func formatDuration(d time.Duration) string {
+	total := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
+
+// formatLapTime formats a timed run as m:ss.hh
+func formatLapTime(d time.Duration) string {
+	hundredths := int(d / (10 * time.Millisecond))
+	return fmt.Sprintf("%d:%02d.%02d", hundredths/6000, hundredths/100%60, hundredths%100)
+}
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/golangdaddy/roadster/pkg/models/car"
+	"github.com/golangdaddy/roadster/pkg/models/profile"
+	"github.com/golangdaddy/roadster/pkg/progression"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// TuningShopScreen sells upgrade parts for one of the player's cars.
+// It reads the profile for the balance; fitting is done by the onBuy callback.
+type TuningShopScreen struct {
+	profile  *profile.PlayerProfile
+	car      *car.Car
+	selected int
+	message  string // Result of the last purchase
+	failed   bool   // Whether message is an error
+
+	onBuy  func(part car.Part) error
+	onBack func()
+}
+
+// NewTuningShopScreen creates a tuning shop for c, one of p's owned cars
+func NewTuningShopScreen(p *profile.PlayerProfile, c *car.Car, onBuy func(part car.Part) error, onBack func()) *TuningShopScreen {
+	return &TuningShopScreen{
+		profile: p,
+		car:     c,
+		onBuy:   onBuy,
+		onBack:  onBack,
+	}
+}
+
+// Update handles input for the tuning shop screen
+func (ts *TuningShopScreen) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		if ts.onBack != nil {
+			ts.onBack()
+		}
+		return nil
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowUp) {
+		ts.selected = (ts.selected + len(car.Parts) - 1) % len(car.Parts)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyArrowDown) {
+		ts.selected = (ts.selected + 1) % len(car.Parts)
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		part := car.Parts[ts.selected]
+		var err error
+		if ts.onBuy != nil {
+			err = ts.onBuy(part)
+		}
+		ts.failed = err != nil
+		ts.message = "FITTED " + strings.ToUpper(part.Name)
+		if err != nil {
+			ts.message = err.Error()
+		}
+	}
+
+	return nil
+}
+
+// Draw renders the tuning shop screen
+func (ts *TuningShopScreen) Draw(screen *ebiten.Image) {
+	width, height := screen.Bounds().Dx(), screen.Bounds().Dy()
+	screen.Fill(color.RGBA{20, 20, 30, 255})
+
+	centerX := float64(width) / 2
+	drawText(screen, "TUNING SHOP", centerX, 50, 40, color.RGBA{255, 200, 50, 255})
+	drawText(screen, fmt.Sprintf("%s %s | LEVEL %d | MONEY: $%.2f", ts.car.Make, ts.car.Model, ts.profile.Level, ts.profile.Money), centerX, 95, 18, color.RGBA{100, 255, 100, 255})
+
+	// Parts list
+	startY := 120.0
+	rowSpacing := 60.0
+	buttonWidth := 760.0
+	buttonHeight := 50.0
+	buttonX := centerX - buttonWidth/2
+
+	first, last := visibleRows(ts.selected, len(car.Parts), garageVisibleRows)
+	for i := first; i < last; i++ {
+		part := car.Parts[i]
+		label := fmt.Sprintf("%s - %s - $%.0f", part.Name, describePart(part), part.Price)
+		required := progression.PartLevel(part)
+		locked := !progression.Unlocked(required, ts.profile.Level)
+		if ts.car.HasPart(part) {
+			label = fmt.Sprintf("%s - %s - FITTED", part.Name, describePart(part))
+		} else if locked {
+			label = fmt.Sprintf("%s - %s - LOCKED UNTIL LEVEL %d", part.Name, describePart(part), required)
+		}
+
+		bgColor := color.RGBA{40, 40, 60, 255}
+		textColor := color.RGBA{255, 255, 255, 255}
+		if i == ts.selected {
+			bgColor = color.RGBA{60, 100, 140, 255}
+			textColor = color.RGBA{200, 240, 255, 255}
+		}
+		if !ts.car.HasPart(part) && part.Price > ts.profile.Money {
+			textColor = color.RGBA{150, 100, 100, 255} // Too expensive
+		}
+		if !ts.car.HasPart(part) && locked {
+			textColor = color.RGBA{110, 110, 110, 255}
+		}
+		drawButton(screen, label, buttonX, startY+float64(i-first)*rowSpacing, buttonWidth, buttonHeight, bgColor, textColor)
+	}
+
+	drawText(screen, formatCarStats(ts.car), centerX, float64(height)-120, 16, color.RGBA{200, 200, 200, 255})
+
+	if ts.message != "" {
+		messageColor := color.RGBA{100, 255, 100, 255}
+		if ts.failed {
+			messageColor = color.RGBA{255, 100, 100, 255}
+		}
+		drawText(screen, ts.message, centerX, float64(height)-85, 18, messageColor)
+	}
+
+	drawText(screen, "Arrow Keys: Choose | Enter: Buy & Fit | Esc: Garage", centerX, float64(height)-50, 18, color.RGBA{150, 150, 150, 255})
+}
+
+// describePart summarises what a part does
+func describePart(part car.Part) string {
+	switch part.Slot {
+	case car.SlotEngine:
+		return fmt.Sprintf("+%.0f%% BHP", (part.BHPMultiplier-1)*100)
+	case car.SlotTank:
+		return fmt.Sprintf("+%.0f L TANK", part.ExtraFuelCapacity)
+	case car.SlotBrakes:
+		return fmt.Sprintf("+%.0f%% STOPPING POWER", part.StoppingPower*100)
+	case car.SlotTyres:
+		return fmt.Sprintf("+%.0f%% GRIP", (part.Grip-1)*100)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,81 @@
+// Package wanted keeps the player's wanted level: the stars a crime earns, how the police lose a player
+// who stays out of their sight, and what an arrest costs
+package wanted
+
+// Stars for each crime, and what it takes to escape or be arrested
+const (
+	MaxStars         = 5
+	TheftStars       = 2   // Stealing a car
+	PoliceTheftStars = 4   // Stealing a patrol car
+	RammingStars     = 1   // Crashing into a patrol car while wanted
+	LoseStarTicks    = 300 // Ticks out of sight of the police that lose a star
+	BustTicks        = 90  // Ticks stopped beside a patrol car before the player is arrested
+	FinePerStar      = 250.0
+	ArrestPoints     = 6 // Penalty points for the arrest
+)
+
+// Outcome is what came of a tick of the chase
+type Outcome int
+
+const (
+	None     Outcome = iota
+	StarLost         // Out of sight long enough for the police to care a little less
+	Escaped          // The last star is gone
+	Busted           // Stopped beside a patrol car for long enough to be arrested
+)
+
+// Level is how much the police want the player
+type Level struct {
+	Stars    int   `json:"stars"`
+	Unseen   int64 `json:"unseen"`   // Ticks since a patrol car last had the player in sight
+	Cornered int64 `json:"cornered"` // Ticks the player has been stopped beside a patrol car
+}
+
+// Wanted reports whether the police are after the player
+func (l *Level) Wanted() bool {
+	return l.Stars > 0
+}
+
+// Commit raises the level for a crime worth stars, committed where the police can see it
+func (l *Level) Commit(stars int) {
+	l.Stars = min(MaxStars, l.Stars+stars)
+	l.Unseen = 0
+}
+
+// Update records a tick of the chase: whether a patrol car can see the player, and whether one has them stopped
+func (l *Level) Update(seen, cornered bool) Outcome {
+	if l.Stars == 0 {
+		return None
+	}
+
+	if cornered {
+		l.Cornered++
+		if l.Cornered >= BustTicks {
+			return Busted
+		}
+	} else {
+		l.Cornered = 0
+	}
+
+	if seen {
+		l.Unseen = 0
+		return None
+	}
+	l.Unseen++
+	if l.Unseen < LoseStarTicks {
+		return None
+	}
+	l.Unseen = 0
+	l.Stars--
+	if l.Stars == 0 {
+		return Escaped
+	}
+	return StarLost
+}
+
+// Arrest clears the level and returns the fine for it
+func (l *Level) Arrest() float64 {
+	fine := float64(l.Stars) * FinePerStar
+	*l = Level{}
+	return fine
+}